@@ -16,6 +16,15 @@ func RandomChoice() *balancerConfig.Config {
 	return &balancerConfig.Config{}
 }
 
+// LeastLoaded creates balancer which selects less loaded connection from two random connections
+// (power of two choices). Load of connection estimates by count of in-flight unary calls,
+// EWMA of observed calls latency and load factor of endpoint from discovery
+func LeastLoaded() *balancerConfig.Config {
+	return &balancerConfig.Config{
+		LeastLoaded: true,
+	}
+}
+
 func SingleConn() *balancerConfig.Config {
 	return &balancerConfig.Config{
 		SingleConn: true,
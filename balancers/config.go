@@ -13,6 +13,7 @@ type balancerType string
 const (
 	typeRoundRobin   = balancerType("round_robin")
 	typeRandomChoice = balancerType("random_choice")
+	typeLeastLoaded  = balancerType("least_loaded")
 	typeSingle       = balancerType("single")
 	typeDisable      = balancerType("disable")
 )
@@ -60,6 +61,8 @@ func createByType(t balancerType) (*balancerConfig.Config, error) {
 		return RandomChoice(), nil
 	case typeRoundRobin:
 		return RoundRobin(), nil
+	case typeLeastLoaded:
+		return LeastLoaded(), nil
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("unknown type of balancer: %s", t))
 	}
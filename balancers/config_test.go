@@ -63,6 +63,18 @@ func TestFromConfig(t *testing.T) {
 			}`,
 			res: balancerConfig.Config{},
 		},
+		{
+			name:   "least_loaded",
+			config: `least_loaded`,
+			res:    balancerConfig.Config{LeastLoaded: true},
+		},
+		{
+			name: "least_loaded/JSON",
+			config: `{
+				"type": "least_loaded"
+			}`,
+			res: balancerConfig.Config{LeastLoaded: true},
+		},
 		{
 			name: "prefer_local_dc",
 			config: `{
@@ -12,6 +12,7 @@ import (
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -33,6 +34,9 @@ type Config struct {
 	meta           *meta.Meta
 
 	excludeGRPCCodesForPessimization []grpcCodes.Code
+
+	circuitBreakerFailures int
+	circuitBreakerCooldown time.Duration
 }
 
 func (c *Config) Credentials() credentials.Credentials {
@@ -44,6 +48,13 @@ func (c *Config) ExcludeGRPCCodesForPessimization() []grpcCodes.Code {
 	return c.excludeGRPCCodesForPessimization
 }
 
+// CircuitBreaker reports about per-endpoint circuit breaker settings.
+//
+// If failures is zero - circuit breaker is disabled and endpoint pessimizes on first error
+func (c *Config) CircuitBreaker() (failures int, cooldown time.Duration) {
+	return c.circuitBreakerFailures, c.circuitBreakerCooldown
+}
+
 // GrpcDialOptions reports about used grpc dialing options
 func (c *Config) GrpcDialOptions() []grpc.DialOption {
 	return append(
@@ -211,6 +222,32 @@ func WithPanicCallback(panicCallback func(e interface{})) Option {
 	}
 }
 
+// WithCircuitBreaker enables per-endpoint circuit breaker.
+//
+// Circuit breaker bans endpoint after failures consecutive pessimizing errors instead of first error.
+// Banned endpoint allows for trial request after cooldown. Cooldown doubles after each failed trial
+// (but no more than 64 initial cooldowns) and resets after success request
+func WithCircuitBreaker(failures int, cooldown time.Duration) Option {
+	return func(c *Config) {
+		c.circuitBreakerFailures = failures
+		c.circuitBreakerCooldown = cooldown
+	}
+}
+
+// WithRetryBudget sets budget of retry attempts which shared between all retry loops of driver
+func WithRetryBudget(b budget.Budget) Option {
+	return func(c *Config) {
+		config.SetRetryBudget(&c.Common, b)
+	}
+}
+
+// WithTraceRetry appends trace of retry loops which shared between all retry loops of driver
+func WithTraceRetry(t trace.Retry, opts ...trace.RetryComposeOption) Option { //nolint:gocritic
+	return func(c *Config) {
+		config.SetRetryTrace(&c.Common, &t, opts...)
+	}
+}
+
 func WithDialTimeout(timeout time.Duration) Option {
 	return func(c *Config) {
 		c.dialTimeout = timeout
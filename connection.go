@@ -11,6 +11,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
 	"github.com/ydb-platform/ydb-go-sdk/v3/discovery"
+	"github.com/ydb-platform/ydb-go-sdk/v3/export"
+	"github.com/ydb-platform/ydb-go-sdk/v3/imports"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	internalCoordination "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination"
@@ -20,6 +22,12 @@ import (
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	internalExport "github.com/ydb-platform/ydb-go-sdk/v3/internal/export"
+	exportConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/export/config"
+	internalImports "github.com/ydb-platform/ydb-go-sdk/v3/internal/imports"
+	importsConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/imports/config"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
 	internalRatelimiter "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter"
 	ratelimiterConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
 	internalScheme "github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme"
@@ -33,6 +41,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/metrics"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
@@ -91,6 +101,9 @@ type Driver struct { //nolint:maligned
 	loggerOpts    []log.Option
 	loggerDetails trace.Detailer
 
+	metrics        metrics.Registry
+	metricsDetails trace.Detailer
+
 	opts []Option
 
 	config  *config.Config
@@ -124,6 +137,15 @@ type Driver struct { //nolint:maligned
 	topic        *topicclientinternal.Client
 	topicOptions []topicoptions.TopicOption
 
+	exportOnce initOnce
+	export     *internalExport.Client
+
+	importOnce initOnce
+	imports    *internalImports.Client
+
+	operationOnce initOnce
+	operation     *internalOperation.Client
+
 	databaseSQLOptions []xsql.ConnectorOption
 
 	pool *conn.Pool
@@ -165,6 +187,9 @@ func (c *Driver) Close(ctx context.Context) error {
 		c.scriptingOnce.Close,
 		c.tableOnce.Close,
 		c.topicOnce.Close,
+		c.exportOnce.Close,
+		c.importOnce.Close,
+		c.operationOnce.Close,
 		c.balancer.Close,
 		c.pool.Release,
 	)
@@ -283,6 +308,51 @@ func (c *Driver) Ratelimiter() ratelimiter.Client {
 	return c.ratelimiter
 }
 
+// Export returns export client
+func (c *Driver) Export() export.Client {
+	c.exportOnce.Init(func() closeFunc {
+		c.export = internalExport.New(
+			c.balancer,
+			exportConfig.New(
+				exportConfig.With(c.config.Common),
+			),
+		)
+		return c.export.Close
+	})
+	// may be nil if driver closed early
+	return c.export
+}
+
+// Import returns import client
+func (c *Driver) Import() imports.Client {
+	c.importOnce.Init(func() closeFunc {
+		c.imports = internalImports.New(
+			c.balancer,
+			importsConfig.New(
+				importsConfig.With(c.config.Common),
+			),
+		)
+		return c.imports.Close
+	})
+	// may be nil if driver closed early
+	return c.imports
+}
+
+// Operation returns client of long-running operations
+func (c *Driver) Operation() operation.Client {
+	c.operationOnce.Init(func() closeFunc {
+		c.operation = internalOperation.New(
+			c.balancer,
+			operationConfig.New(
+				operationConfig.With(c.config.Common),
+			),
+		)
+		return c.operation.Close
+	})
+	// may be nil if driver closed early
+	return c.operation
+}
+
 // Discovery returns discovery client
 func (c *Driver) Discovery() discovery.Client {
 	c.discoveryOnce.Init(func() closeFunc {
@@ -436,6 +506,22 @@ func newConnectionFromOptions(ctx context.Context, opts ...Option) (_ *Driver, e
 			}
 		}
 	}
+	if d.metrics != nil {
+		for _, opt := range []Option{
+			WithTraceDriver(metrics.Driver(d.metrics, d.metricsDetails)),
+			WithTraceTable(metrics.Table(d.metrics, d.metricsDetails)),
+			WithTraceTopic(metrics.Topic(d.metrics, d.metricsDetails)),
+			WithTraceDatabaseSQL(metrics.DatabaseSQL(d.metrics, d.metricsDetails)),
+			WithTraceRetry(metrics.Retry(d.metrics, d.metricsDetails)),
+		} {
+			if opt != nil {
+				err = opt(ctx, d)
+				if err != nil {
+					return nil, xerrors.WithStackTrace(err)
+				}
+			}
+		}
+	}
 	d.config = config.New(d.options...)
 	return d, nil
 }
@@ -3,6 +3,7 @@ package coordination
 import (
 	"context"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 )
 
@@ -11,4 +12,13 @@ type Client interface {
 	AlterNode(ctx context.Context, path string, config NodeConfig) (err error)
 	DropNode(ctx context.Context, path string) (err error)
 	DescribeNode(ctx context.Context, path string) (_ *scheme.Entry, _ *NodeConfig, err error)
+
+	// Session starts a new session with the coordination node at path.
+	// The session keeps itself alive and reconnects the underlying stream on network errors.
+	// The context of the session (Session.Context) is canceled when the session is closed or lost.
+	Session(ctx context.Context, path string, opts ...options.SessionOption) (Session, error)
+
+	// Election returns a leader election on the semaphore name of the coordination node at path.
+	// Sessions of the election are started with opts.
+	Election(path, name string, opts ...options.SessionOption) Election
 }
@@ -0,0 +1,49 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoLeader returned if the election has no leader at the moment
+var ErrNoLeader = errors.New("ydb: coordination election has no leader")
+
+// Leader describes a leader of the election
+type Leader struct {
+	// SessionID is the identifier of the coordination session of the leader
+	SessionID uint64
+
+	// OrderID increases with each new leadership and may be used as a fencing token
+	OrderID uint64
+
+	// Data is the value proposed by the leader on campaign
+	Data []byte
+}
+
+// Election is a leader election built on top of an exclusive ephemeral semaphore of the coordination node.
+//
+// Election creates its own coordination session and re-creates it if the session is lost.
+type Election interface {
+	// Campaign blocks until the caller is elected or ctx is done.
+	// data is published to observers as the value of the leader.
+	// If the session is lost while waiting, Campaign continues on a new session.
+	Campaign(ctx context.Context, data []byte) (*Leader, error)
+
+	// Resign gives up leadership obtained by Campaign
+	Resign(ctx context.Context) error
+
+	// Leader returns the current leader or ErrNoLeader
+	Leader(ctx context.Context) (*Leader, error)
+
+	// Observe sends the current leader to the returned channel on each change of leadership.
+	// The channel is closed when ctx is done or the election is closed.
+	Observe(ctx context.Context) <-chan Leader
+
+	// Done returns a channel which is closed when leadership obtained by the last Campaign is lost
+	// or resigned. Returns a closed channel if the caller is not a leader.
+	Done() <-chan struct{}
+
+	// Close stops the session of the election. Leadership (if any) is given up.
+	// Campaign and Leader of the closed election return an error.
+	Close(ctx context.Context) error
+}
@@ -3,9 +3,11 @@ package coordination_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
 )
 
 //nolint:errcheck
@@ -38,3 +40,66 @@ func Example() {
 	}
 	fmt.Printf("node description: %+v\nnode config: %+v\n", e, c)
 }
+
+//nolint:errcheck
+func Example_semaphore() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	// start session on the coordination node
+	session, err := db.Coordination().Session(ctx, "/local/test")
+	if err != nil {
+		fmt.Printf("failed to start session: %v", err)
+		return
+	}
+	defer session.Close(ctx)
+	// create mutex-like semaphore
+	err = session.CreateSemaphore(ctx, "lock", 1)
+	if err != nil {
+		fmt.Printf("failed to create semaphore: %v", err)
+		return
+	}
+	// acquire semaphore
+	lease, err := session.AcquireSemaphore(ctx, "lock", 1, options.WithAcquireTimeout(time.Minute))
+	if err != nil {
+		fmt.Printf("failed to acquire semaphore: %v", err)
+		return
+	}
+	defer lease.Release(ctx)
+	// do work while lease is held
+	select {
+	case <-lease.Context().Done():
+		fmt.Println("semaphore lost")
+	case <-time.After(time.Second):
+		fmt.Println("work done")
+	}
+}
+
+//nolint:errcheck
+func Example_election() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	election := db.Coordination().Election("/local/test", "leader")
+	defer election.Close(ctx)
+	for {
+		// wait for leadership
+		leader, err := election.Campaign(ctx, []byte("host-1"))
+		if err != nil {
+			fmt.Printf("failed to campaign: %v", err)
+			return
+		}
+		fmt.Printf("elected with fencing token %d\n", leader.OrderID)
+		// do work while leader
+		<-election.Done()
+		fmt.Println("leadership lost, campaign again")
+	}
+}
@@ -0,0 +1,195 @@
+package options
+
+import (
+	"math"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+)
+
+const (
+	DefaultSessionTimeout          = 5 * time.Second
+	DefaultSessionStartTimeout     = time.Second
+	DefaultSessionStopTimeout      = time.Second
+	DefaultSessionKeepAliveTimeout = 10 * time.Second
+	DefaultSessionReconnectDelay   = 500 * time.Millisecond
+)
+
+// CreateSessionOptions contains settings of a coordination session
+type CreateSessionOptions struct {
+	Description             string
+	SessionTimeout          time.Duration
+	SessionStartTimeout     time.Duration
+	SessionStopTimeout      time.Duration
+	SessionKeepAliveTimeout time.Duration
+	SessionReconnectDelay   time.Duration
+}
+
+// SessionOption configures a coordination session
+type SessionOption func(c *CreateSessionOptions)
+
+// NewCreateSessionOptions makes session options with defaults and applies opts over them
+func NewCreateSessionOptions(opts ...SessionOption) *CreateSessionOptions {
+	c := &CreateSessionOptions{
+		SessionTimeout:          DefaultSessionTimeout,
+		SessionStartTimeout:     DefaultSessionStartTimeout,
+		SessionStopTimeout:      DefaultSessionStopTimeout,
+		SessionKeepAliveTimeout: DefaultSessionKeepAliveTimeout,
+		SessionReconnectDelay:   DefaultSessionReconnectDelay,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(c)
+		}
+	}
+	return c
+}
+
+// WithDescription returns a SessionOption that specifies a user-defined description
+// which may be used to describe the client
+func WithDescription(description string) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.Description = description
+	}
+}
+
+// WithSessionTimeout returns a SessionOption that specifies the timeout during which client may restore
+// a detached session. The client is forced to terminate the session if the last successful session request
+// occurred earlier than this time.
+func WithSessionTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionTimeout = timeout
+	}
+}
+
+// WithSessionStartTimeout returns a SessionOption that specifies the time that the client should wait for
+// a response to the StartSession request from the server before it terminates the gRPC stream and tries
+// to reconnect.
+func WithSessionStartTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionStartTimeout = timeout
+	}
+}
+
+// WithSessionStopTimeout returns a SessionOption that specifies the time that the client should wait for
+// a response to the StopSession request from the server before it terminates the gRPC stream.
+func WithSessionStopTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionStopTimeout = timeout
+	}
+}
+
+// WithSessionKeepAliveTimeout returns a SessionOption that specifies the time that the client will wait
+// before it terminates the gRPC stream and tries to reconnect if no responses were received from the server.
+func WithSessionKeepAliveTimeout(timeout time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionKeepAliveTimeout = timeout
+	}
+}
+
+// WithSessionReconnectDelay returns a SessionOption that specifies the time that the client will wait
+// before it tries to reconnect the underlying gRPC stream in case of error.
+func WithSessionReconnectDelay(delay time.Duration) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionReconnectDelay = delay
+	}
+}
+
+// CreateSemaphoreOption configures CreateSemaphore request
+type CreateSemaphoreOption func(r *Ydb_Coordination.SessionRequest_CreateSemaphore)
+
+// WithCreateData returns a CreateSemaphoreOption that attaches user-defined data to the semaphore
+func WithCreateData(data []byte) CreateSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_CreateSemaphore) {
+		r.Data = data
+	}
+}
+
+// UpdateSemaphoreOption configures UpdateSemaphore request
+type UpdateSemaphoreOption func(r *Ydb_Coordination.SessionRequest_UpdateSemaphore)
+
+// WithUpdateData returns an UpdateSemaphoreOption that replaces user-defined data of the semaphore
+func WithUpdateData(data []byte) UpdateSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_UpdateSemaphore) {
+		r.Data = data
+	}
+}
+
+// DeleteSemaphoreOption configures DeleteSemaphore request
+type DeleteSemaphoreOption func(r *Ydb_Coordination.SessionRequest_DeleteSemaphore)
+
+// WithForceDelete returns a DeleteSemaphoreOption that allows deleting a semaphore
+// which is currently acquired by other sessions
+func WithForceDelete(force bool) DeleteSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_DeleteSemaphore) {
+		r.Force = force
+	}
+}
+
+// DescribeSemaphoreOption configures DescribeSemaphore request
+type DescribeSemaphoreOption func(r *Ydb_Coordination.SessionRequest_DescribeSemaphore)
+
+// WithDescribeOwners returns a DescribeSemaphoreOption that includes semaphore owners into the description
+func WithDescribeOwners(describeOwners bool) DescribeSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		r.IncludeOwners = describeOwners
+	}
+}
+
+// WithDescribeWaiters returns a DescribeSemaphoreOption that includes semaphore waiters into the description
+func WithDescribeWaiters(describeWaiters bool) DescribeSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		r.IncludeWaiters = describeWaiters
+	}
+}
+
+// AcquireSemaphoreOption configures AcquireSemaphore request
+type AcquireSemaphoreOption func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore)
+
+// NewAcquireSemaphoreRequest makes AcquireSemaphore request which waits for the semaphore
+// until it is acquired and applies opts over it
+func NewAcquireSemaphoreRequest(
+	name string, count uint64, opts ...AcquireSemaphoreOption,
+) *Ydb_Coordination.SessionRequest_AcquireSemaphore {
+	r := &Ydb_Coordination.SessionRequest_AcquireSemaphore{
+		Name:          name,
+		Count:         count,
+		TimeoutMillis: math.MaxUint64,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(r)
+		}
+	}
+	return r
+}
+
+// WithEphemeral returns an AcquireSemaphoreOption that makes the semaphore ephemeral: it is created
+// on the first acquire and deleted after the last release
+func WithEphemeral(ephemeral bool) AcquireSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore) {
+		r.Ephemeral = ephemeral
+	}
+}
+
+// WithAcquireTimeout returns an AcquireSemaphoreOption that limits the time the session waits in
+// the semaphore queue. Zero timeout means try-acquire without waiting.
+func WithAcquireTimeout(timeout time.Duration) AcquireSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore) {
+		r.TimeoutMillis = uint64(timeout.Milliseconds())
+	}
+}
+
+// WithTryAcquire returns an AcquireSemaphoreOption that fails acquire immediately if the semaphore
+// cannot be acquired without waiting
+func WithTryAcquire() AcquireSemaphoreOption {
+	return WithAcquireTimeout(0)
+}
+
+// WithAcquireData returns an AcquireSemaphoreOption that attaches user-defined data to the owner
+// (or waiter) record of the session
+func WithAcquireData(data []byte) AcquireSemaphoreOption {
+	return func(r *Ydb_Coordination.SessionRequest_AcquireSemaphore) {
+		r.Data = data
+	}
+}
@@ -0,0 +1,99 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+)
+
+var (
+	// ErrSessionClosed returned on calls of closed or lost session
+	ErrSessionClosed = errors.New("ydb: coordination session closed")
+
+	// ErrAcquireTimeout returned if semaphore was not acquired during acquire timeout
+	ErrAcquireTimeout = errors.New("ydb: coordination semaphore acquire timeout")
+)
+
+// Session is a client session of the coordination node.
+//
+// Semaphores acquired by the session are held until they are released or the session is lost.
+type Session interface {
+	// Context returns the context of the session. It is canceled when the session is closed or lost.
+	Context() context.Context
+
+	// SessionID returns the identifier of the session assigned by the server
+	SessionID() uint64
+
+	// Close stops the session and releases all semaphores acquired by it
+	Close(ctx context.Context) error
+
+	// CreateSemaphore creates a new semaphore with the given limit
+	CreateSemaphore(ctx context.Context, name string, limit uint64, opts ...options.CreateSemaphoreOption) error
+
+	// UpdateSemaphore updates data of the semaphore
+	UpdateSemaphore(ctx context.Context, name string, opts ...options.UpdateSemaphoreOption) error
+
+	// DeleteSemaphore deletes the semaphore
+	DeleteSemaphore(ctx context.Context, name string, opts ...options.DeleteSemaphoreOption) error
+
+	// DescribeSemaphore returns the current state of the semaphore
+	DescribeSemaphore(
+		ctx context.Context,
+		name string,
+		opts ...options.DescribeSemaphoreOption,
+	) (*SemaphoreDescription, error)
+
+	// WatchSemaphore sends the state of the semaphore to the returned channel
+	// initially and on each change of its data or owners.
+	// The channel is closed when ctx is done or the session is closed or lost.
+	WatchSemaphore(
+		ctx context.Context,
+		name string,
+		opts ...options.DescribeSemaphoreOption,
+	) (<-chan *SemaphoreDescription, error)
+
+	// AcquireSemaphore acquires count tokens of the semaphore.
+	// It blocks until the semaphore is acquired, the acquire timeout expires (ErrAcquireTimeout)
+	// or ctx is done.
+	AcquireSemaphore(
+		ctx context.Context,
+		name string,
+		count uint64,
+		opts ...options.AcquireSemaphoreOption,
+	) (Lease, error)
+}
+
+// Lease is an acquired semaphore
+type Lease interface {
+	// Context returns the context of the lease. It is canceled when the lease is released
+	// or the session is closed or lost.
+	Context() context.Context
+
+	// Release releases the acquired semaphore
+	Release(ctx context.Context) error
+
+	// Session returns the session which acquired the semaphore
+	Session() Session
+}
+
+// SemaphoreDescription describes state of the semaphore
+type SemaphoreDescription struct {
+	Name      string
+	Data      []byte
+	Count     uint64
+	Limit     uint64
+	Ephemeral bool
+	Owners    []*SemaphoreSession
+	Waiters   []*SemaphoreSession
+}
+
+// SemaphoreSession describes an owner or a waiter of the semaphore
+type SemaphoreSession struct {
+	SessionID uint64
+	OrderID   uint64
+	Timeout   time.Duration
+	Count     uint64
+	Data      []byte
+}
@@ -47,21 +47,17 @@ func createTable(ctx context.Context, c table.Client, prefix, tableName string)
 		return fmt.Errorf("failed to create table: %w", err)
 	}
 
-	err = c.Do(ctx, func(ctx context.Context, s table.Session) error {
-		query := fmt.Sprintf(`
-PRAGMA TablePathPrefix("%v");
-
-ALTER TABLE
-	%v
-ADD CHANGEFEED
-	feed
-WITH (
-	FORMAT = 'JSON',
-	MODE = 'NEW_AND_OLD_IMAGES'
-)
-`, prefix, tableName)
-		return s.ExecuteSchemeQuery(ctx, query)
-	})
+	err = c.Do(ctx,
+		func(ctx context.Context, s table.Session) error {
+			return s.AlterTable(ctx, path.Join(prefix, tableName),
+				options.WithAddChangefeed("feed",
+					options.WithChangefeedFormat(options.ChangefeedFormatJSON),
+					options.WithChangefeedMode(options.ChangefeedModeNewAndOldImages),
+				),
+			)
+		},
+		table.WithIdempotent(),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to add changefeed to test table: %w", err)
 	}
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/export/options"
+)
+
+type Client interface {
+	// ExportToS3 starts export of items into the bucket of S3 compatible storage.
+	// Export is a long-running operation: ExportToS3 returns right after the operation was accepted
+	// by the server. Use GetOperation with Operation.ID to track the progress of export.
+	ExportToS3(ctx context.Context, s3 S3, items []Item, opts ...options.ExportToS3Option) (*Operation, error)
+
+	// GetOperation returns the actual state of the export operation with id.
+	// If the operation is completed with failure, GetOperation returns the error of the operation.
+	GetOperation(ctx context.Context, id string) (*Operation, error)
+}
+
+// S3 describes the connection to S3 compatible storage
+type S3 struct {
+	Endpoint  string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// Item describes a table which exports into objects with DestinationPrefix
+type Item struct {
+	// SourcePath is a database path of the table
+	SourcePath string
+	// DestinationPrefix is a prefix of the objects in the bucket
+	DestinationPrefix string
+}
+
+// Progress is a stage of the export operation
+type Progress int32
+
+const (
+	ProgressUnspecified = Progress(iota)
+	ProgressPreparing
+	ProgressTransferData
+	ProgressDone
+	ProgressCancellation
+	ProgressCancelled
+)
+
+func (p Progress) String() string {
+	switch p {
+	case ProgressPreparing:
+		return "preparing"
+	case ProgressTransferData:
+		return "transfer_data"
+	case ProgressDone:
+		return "done"
+	case ProgressCancellation:
+		return "cancellation"
+	case ProgressCancelled:
+		return "cancelled"
+	default:
+		return "unspecified"
+	}
+}
+
+// ItemProgress describes the progress of export of a single item
+type ItemProgress struct {
+	PartsTotal     uint32
+	PartsCompleted uint32
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+// Operation is a handle of the long-running export operation
+type Operation struct {
+	// ID is an identifier of the operation
+	ID string
+	// Ready is true if the operation is completed
+	Ready bool
+	// Progress is a stage of the export
+	Progress Progress
+	// ItemsProgress contains progress of items in order of export items
+	ItemsProgress []ItemProgress
+}
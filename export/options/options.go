@@ -0,0 +1,55 @@
+package options
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+)
+
+// ExportToS3Option configures export to S3 compatible storage
+type ExportToS3Option func(settings *Ydb_Export.ExportToS3Settings)
+
+// WithDescription specifies a user-defined description of the export operation
+func WithDescription(description string) ExportToS3Option {
+	return func(settings *Ydb_Export.ExportToS3Settings) {
+		settings.Description = description
+	}
+}
+
+// WithNumberOfRetries specifies how many times the server retries failed uploads of export parts
+func WithNumberOfRetries(numberOfRetries uint32) ExportToS3Option {
+	return func(settings *Ydb_Export.ExportToS3Settings) {
+		settings.NumberOfRetries = numberOfRetries
+	}
+}
+
+// WithCompression specifies the codec of exported data.
+// Codec is defined in format "<codec>[-<level>]", for example "zstd" or "zstd-3".
+// Data is not compressed by default.
+func WithCompression(codec string) ExportToS3Option {
+	return func(settings *Ydb_Export.ExportToS3Settings) {
+		settings.Compression = codec
+	}
+}
+
+// WithRegion specifies the region of the bucket
+func WithRegion(region string) ExportToS3Option {
+	return func(settings *Ydb_Export.ExportToS3Settings) {
+		settings.Region = region
+	}
+}
+
+// WithStorageClass specifies the S3 storage class of exported objects, for example "STANDARD_IA".
+// Unknown storage classes are ignored.
+func WithStorageClass(storageClass string) ExportToS3Option {
+	return func(settings *Ydb_Export.ExportToS3Settings) {
+		settings.StorageClass = Ydb_Export.ExportToS3Settings_StorageClass(
+			Ydb_Export.ExportToS3Settings_StorageClass_value[storageClass],
+		)
+	}
+}
+
+// WithHTTP makes the server use plain HTTP instead of HTTPS to access the storage
+func WithHTTP() ExportToS3Option {
+	return func(settings *Ydb_Export.ExportToS3Settings) {
+		settings.Scheme = Ydb_Export.ExportToS3Settings_HTTP
+	}
+}
@@ -0,0 +1,87 @@
+package imports
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/imports/options"
+)
+
+type Client interface {
+	// ImportFromS3 starts import of items from the bucket of S3 compatible storage.
+	// Import is a long-running operation: ImportFromS3 returns right after the operation was accepted
+	// by the server. Use GetOperation with Operation.ID to track the progress of import.
+	ImportFromS3(ctx context.Context, s3 S3, items []Item, opts ...options.ImportFromS3Option) (*Operation, error)
+
+	// GetOperation returns the actual state of the import operation with id.
+	// If the operation is completed with failure, GetOperation returns the error of the operation.
+	GetOperation(ctx context.Context, id string) (*Operation, error)
+}
+
+// S3 describes the connection to S3 compatible storage
+type S3 struct {
+	Endpoint  string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// Item describes a table which imports from objects with SourcePrefix
+type Item struct {
+	// SourcePrefix is a prefix of the objects in the bucket
+	SourcePrefix string
+	// DestinationPath is a database path of the table
+	DestinationPath string
+}
+
+// Progress is a stage of the import operation
+type Progress int32
+
+const (
+	ProgressUnspecified = Progress(iota)
+	ProgressPreparing
+	ProgressTransferData
+	ProgressBuildIndexes
+	ProgressDone
+	ProgressCancellation
+	ProgressCancelled
+)
+
+func (p Progress) String() string {
+	switch p {
+	case ProgressPreparing:
+		return "preparing"
+	case ProgressTransferData:
+		return "transfer_data"
+	case ProgressBuildIndexes:
+		return "build_indexes"
+	case ProgressDone:
+		return "done"
+	case ProgressCancellation:
+		return "cancellation"
+	case ProgressCancelled:
+		return "cancelled"
+	default:
+		return "unspecified"
+	}
+}
+
+// ItemProgress describes the progress of import of a single item
+type ItemProgress struct {
+	PartsTotal     uint32
+	PartsCompleted uint32
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+// Operation is a handle of the long-running import operation
+type Operation struct {
+	// ID is an identifier of the operation
+	ID string
+	// Ready is true if the operation is completed
+	Ready bool
+	// Progress is a stage of the import
+	Progress Progress
+	// ItemsProgress contains progress of items in order of import items
+	ItemsProgress []ItemProgress
+}
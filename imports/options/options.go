@@ -0,0 +1,36 @@
+package options
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+)
+
+// ImportFromS3Option configures import from S3 compatible storage
+type ImportFromS3Option func(settings *Ydb_Import.ImportFromS3Settings)
+
+// WithDescription specifies a user-defined description of the import operation
+func WithDescription(description string) ImportFromS3Option {
+	return func(settings *Ydb_Import.ImportFromS3Settings) {
+		settings.Description = description
+	}
+}
+
+// WithNumberOfRetries specifies how many times the server retries failed downloads of import parts
+func WithNumberOfRetries(numberOfRetries uint32) ImportFromS3Option {
+	return func(settings *Ydb_Import.ImportFromS3Settings) {
+		settings.NumberOfRetries = numberOfRetries
+	}
+}
+
+// WithRegion specifies the region of the bucket
+func WithRegion(region string) ImportFromS3Option {
+	return func(settings *Ydb_Import.ImportFromS3Settings) {
+		settings.Region = region
+	}
+}
+
+// WithHTTP makes the server use plain HTTP instead of HTTPS to access the storage
+func WithHTTP() ImportFromS3Option {
+	return func(settings *Ydb_Import.ImportFromS3Settings) {
+		settings.Scheme = Ydb_Import.ImportFromS3Settings_HTTP
+	}
+}
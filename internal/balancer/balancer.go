@@ -3,6 +3,7 @@ package balancer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
@@ -37,6 +38,8 @@ type Balancer struct {
 	discoveryClient   discoveryClient
 	discoveryRepeater repeater.Repeater
 	localDCDetector   func(ctx context.Context, endpoints []endpoint.Endpoint) (string, error)
+	loads             *connLoads      // not nil for least loaded balancing
+	breaker           *circuitBreaker // not nil if circuit breaker enabled
 
 	mu               xsync.RWMutex
 	connectionsState *connectionsState
@@ -56,6 +59,18 @@ func (b *Balancer) HasNode(id uint32) bool {
 	return false
 }
 
+// NodeIDs returns IDs of nodes of discovered endpoints
+func (b *Balancer) NodeIDs() []uint32 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	ids := make([]uint32, 0, len(b.connectionsState.connByNodeID))
+	for id := range b.connectionsState.connByNodeID {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func (b *Balancer) OnUpdate(onApplyDiscoveredEndpoints func(ctx context.Context, endpoints []endpoint.Info)) {
 	b.mu.WithLock(func() {
 		b.onApplyDiscoveredEndpoints = append(b.onApplyDiscoveredEndpoints, onApplyDiscoveredEndpoints)
@@ -144,13 +159,23 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []end
 	}()
 
 	connections := endpointsToConnections(b.pool, endpoints)
-	for _, c := range connections {
-		b.pool.Allow(ctx, c)
-		c.Endpoint().Touch()
+	for i, c := range connections {
+		// connections with open circuit are allowed by circuit breaker after cooldown
+		if b.breaker == nil || !b.breaker.isOpen(c) {
+			b.pool.Allow(ctx, c)
+		}
+		c.Endpoint().Touch(endpoint.WithLoadFactor(endpoints[i].LoadFactor()))
 	}
 
 	info := balancerConfig.Info{SelfLocation: localDC}
 	state := newConnectionsState(connections, b.balancerConfig.IsPreferConn, info, b.balancerConfig.AllowFalback)
+	if b.loads != nil {
+		b.loads.retain(connections)
+		state.loads = b.loads
+	}
+	if b.breaker != nil {
+		b.breaker.retain(connections)
+	}
 
 	endpointsInfo := make([]endpoint.Info, len(endpoints))
 	for i, e := range endpoints {
@@ -178,6 +203,10 @@ func (b *Balancer) Close(ctx context.Context) (err error) {
 		b.discoveryRepeater.Stop()
 	}
 
+	if b.breaker != nil {
+		b.breaker.close()
+	}
+
 	if err = b.discoveryClient.Close(ctx); err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -226,6 +255,16 @@ func New(
 		b.balancerConfig = *config
 	}
 
+	if b.balancerConfig.LeastLoaded && !b.balancerConfig.SingleConn {
+		b.loads = newConnLoads()
+	}
+
+	if failures, cooldown := driverConfig.CircuitBreaker(); failures > 0 {
+		b.breaker = newCircuitBreaker(failures, cooldown, func(cc conn.Conn) {
+			b.pool.Allow(context.Background(), cc)
+		})
+	}
+
 	if b.balancerConfig.SingleConn {
 		b.applyDiscoveredEndpoints(ctx, []endpoint.Endpoint{
 			endpoint.New(driverConfig.Endpoint()),
@@ -255,6 +294,13 @@ func (b *Balancer) Invoke(
 	opts ...grpc.CallOption,
 ) error {
 	return b.wrapCall(ctx, func(ctx context.Context, cc conn.Conn) error {
+		if b.loads != nil {
+			load, start := b.loads.get(cc), time.Now()
+			load.start()
+			defer func() {
+				load.done(time.Since(start))
+			}()
+		}
 		return cc.Invoke(ctx, method, args, reply, opts...)
 	})
 }
@@ -284,11 +330,16 @@ func (b *Balancer) wrapCall(ctx context.Context, f func(ctx context.Context, cc
 
 	defer func() {
 		if err == nil {
+			if b.breaker != nil {
+				b.breaker.onSuccess(cc)
+			}
 			if cc.GetState() == conn.Banned {
 				b.pool.Allow(ctx, cc)
 			}
 		} else if xerrors.MustPessimizeEndpoint(err, b.driverConfig.ExcludeGRPCCodesForPessimization()...) {
-			b.pool.Ban(ctx, cc, err)
+			if b.breaker == nil || b.breaker.onFailure(cc) {
+				b.pool.Ban(ctx, cc, err)
+			}
 		}
 	}()
 
@@ -0,0 +1,160 @@
+package balancer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+)
+
+const (
+	defaultCircuitBreakerCooldown = time.Second
+
+	// maxCircuitBreakerCooldownFactor limits growth of cooldown after failed trial requests
+	maxCircuitBreakerCooldownFactor = 64
+)
+
+// circuit is a state of circuit breaker for one connection
+type circuit struct {
+	failures int           // consecutive pessimizing errors
+	open     bool          // connection banned by circuit breaker
+	halfOpen bool          // connection allowed for trial request after cooldown
+	cooldown time.Duration // current cooldown, grows after failed trial requests
+	timer    *time.Timer
+}
+
+// circuitBreaker decides when connection must be banned or allowed in connections pool.
+// Connection bans after threshold of consecutive pessimizing errors and allows after cooldown
+// for trial request. Failed trial request bans connection again with doubled cooldown
+type circuitBreaker struct {
+	threshold   int
+	cooldown    time.Duration
+	maxCooldown time.Duration
+	allow       func(cc conn.Conn)
+
+	mu       sync.Mutex
+	closed   bool
+	circuits map[conn.Conn]*circuit
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration, allow func(cc conn.Conn)) *circuitBreaker {
+	if cooldown <= 0 {
+		cooldown = defaultCircuitBreakerCooldown
+	}
+	return &circuitBreaker{
+		threshold:   threshold,
+		cooldown:    cooldown,
+		maxCooldown: cooldown * maxCircuitBreakerCooldownFactor,
+		allow:       allow,
+		circuits:    make(map[conn.Conn]*circuit),
+	}
+}
+
+// onFailure registers pessimizing error of connection and reports that connection must be banned
+func (b *circuitBreaker) onFailure(cc conn.Conn) (ban bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, has := b.circuits[cc]
+	if !has {
+		c = &circuit{cooldown: b.cooldown}
+		b.circuits[cc] = c
+	}
+	c.failures++
+
+	switch {
+	case c.halfOpen:
+		c.halfOpen = false
+		c.cooldown *= 2
+		if c.cooldown > b.maxCooldown {
+			c.cooldown = b.maxCooldown
+		}
+		b.openNeedLock(cc, c)
+		return true
+	case c.open:
+		// banned connection used because there are no other connections
+		return true
+	case c.failures >= b.threshold:
+		b.openNeedLock(cc, c)
+		return true
+	default:
+		return false
+	}
+}
+
+// onSuccess registers success request of connection and resets circuit of connection
+func (b *circuitBreaker) onSuccess(cc conn.Conn) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, has := b.circuits[cc]; has {
+		if c.timer != nil {
+			c.timer.Stop()
+		}
+		delete(b.circuits, cc)
+	}
+}
+
+// isOpen reports that connection is banned by circuit breaker and waits for cooldown
+func (b *circuitBreaker) isOpen(cc conn.Conn) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, has := b.circuits[cc]
+	return has && c.open
+}
+
+func (b *circuitBreaker) openNeedLock(cc conn.Conn, c *circuit) {
+	if b.closed {
+		return
+	}
+	c.open = true
+	c.timer = time.AfterFunc(c.cooldown, func() {
+		b.halfOpen(cc, c)
+	})
+}
+
+func (b *circuitBreaker) halfOpen(cc conn.Conn, c *circuit) {
+	b.mu.Lock()
+	if b.closed || !c.open || b.circuits[cc] != c {
+		b.mu.Unlock()
+		return
+	}
+	c.open = false
+	c.halfOpen = true
+	b.mu.Unlock()
+
+	b.allow(cc)
+}
+
+// retain removes circuits of connections which are not in conns
+func (b *circuitBreaker) retain(conns []conn.Conn) {
+	actual := make(map[conn.Conn]struct{}, len(conns))
+	for _, cc := range conns {
+		actual[cc] = struct{}{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for cc, c := range b.circuits {
+		if _, has := actual[cc]; !has {
+			if c.timer != nil {
+				c.timer.Stop()
+			}
+			delete(b.circuits, cc)
+		}
+	}
+}
+
+func (b *circuitBreaker) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for _, c := range b.circuits {
+		if c.timer != nil {
+			c.timer.Stop()
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package balancer
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Discovery"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// newCircuitBreakerTestBalancer makes balancer with circuit breaker over one endpoint which refuses connections
+func newCircuitBreakerTestBalancer(t *testing.T, failures int, cooldown time.Duration) *Balancer {
+	listener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: localIP})
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	cfg := config.New(config.WithCircuitBreaker(failures, cooldown))
+	b := &Balancer{
+		driverConfig: cfg,
+		pool:         conn.NewPool(cfg),
+		discoveryClient: discoveryMock{endpoints: []endpoint.Endpoint{
+			&mock.Endpoint{AddrField: address},
+		}},
+	}
+	b.breaker = newCircuitBreaker(failures, cooldown, func(cc conn.Conn) {
+		b.pool.Allow(context.Background(), cc)
+	})
+	require.NoError(t, b.clusterDiscoveryAttempt(xtest.Context(t)))
+	t.Cleanup(func() {
+		b.breaker.close()
+		_ = b.pool.Release(context.Background())
+	})
+
+	return b
+}
+
+func invokeCircuitBreakerTestBalancer(t *testing.T, b *Balancer) conn.Conn {
+	ctx := xtest.Context(t)
+	cc, err := b.getConn(ctx)
+	require.NoError(t, err)
+	require.Error(t, b.Invoke(ctx,
+		"/Ydb.Discovery.V1.DiscoveryService/WhoAmI",
+		&Ydb_Discovery.WhoAmIRequest{},
+		&Ydb_Discovery.WhoAmIResponse{},
+	))
+	return cc
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("Threshold", func(t *testing.T) {
+		const failures = 3
+		b := newCircuitBreakerTestBalancer(t, failures, time.Hour)
+
+		for i := 0; i < failures-1; i++ {
+			cc := invokeCircuitBreakerTestBalancer(t, b)
+			require.NotEqual(t, conn.Banned, cc.GetState(), "failure %d of %d", i+1, failures)
+			require.False(t, b.breaker.isOpen(cc))
+		}
+		cc := invokeCircuitBreakerTestBalancer(t, b)
+		require.Equal(t, conn.Banned, cc.GetState())
+		require.True(t, b.breaker.isOpen(cc))
+	})
+	t.Run("KeepBannedOnDiscovery", func(t *testing.T) {
+		b := newCircuitBreakerTestBalancer(t, 1, time.Hour)
+
+		cc := invokeCircuitBreakerTestBalancer(t, b)
+		require.Equal(t, conn.Banned, cc.GetState())
+		require.NoError(t, b.clusterDiscoveryAttempt(xtest.Context(t)))
+		require.Equal(t, conn.Banned, cc.GetState())
+	})
+	t.Run("HalfOpen", func(t *testing.T) {
+		b := newCircuitBreakerTestBalancer(t, 1, time.Millisecond)
+
+		cc := invokeCircuitBreakerTestBalancer(t, b)
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return cc.GetState() != conn.Banned
+		})
+
+		// failed trial request bans connection with doubled cooldown
+		cc = invokeCircuitBreakerTestBalancer(t, b)
+		b.breaker.mu.Lock()
+		require.Equal(t, 2*time.Millisecond, b.breaker.circuits[cc].cooldown)
+		b.breaker.mu.Unlock()
+	})
+	t.Run("MaxCooldown", func(t *testing.T) {
+		b := newCircuitBreaker(1, time.Hour, func(cc conn.Conn) {})
+		defer b.close()
+		cc := &mock.Conn{AddrField: "1"}
+		require.True(t, b.onFailure(cc))
+		for i := 0; i < 10; i++ {
+			b.mu.Lock()
+			b.circuits[cc].timer.Stop()
+			b.circuits[cc].open = false
+			b.circuits[cc].halfOpen = true
+			b.mu.Unlock()
+			require.True(t, b.onFailure(cc))
+		}
+		require.Equal(t, 64*time.Hour, b.circuits[cc].cooldown)
+	})
+}
@@ -16,6 +16,9 @@ type connectionsState struct {
 	all      []conn.Conn
 
 	rand xrand.Rand
+
+	// loads is not nil for least loaded balancing
+	loads *connLoads
 }
 
 func newConnectionsState(
@@ -52,7 +55,7 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 	}
 
 	try := func(conns []conn.Conn) conn.Conn {
-		c, tryFailed := s.selectRandomConnection(conns, false)
+		c, tryFailed := s.selectConnection(conns, false)
 		failedCount += tryFailed
 		return c
 	}
@@ -81,6 +84,46 @@ func (s *connectionsState) preferConnection(ctx context.Context) conn.Conn {
 	return nil
 }
 
+func (s *connectionsState) selectConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
+	if s.loads != nil {
+		return s.selectLeastLoadedConnection(conns, allowBanned)
+	}
+	return s.selectRandomConnection(conns, allowBanned)
+}
+
+// selectLeastLoadedConnection selects connection with "power of two choices" algorithm:
+// it takes two random connections and returns less loaded one
+func (s *connectionsState) selectLeastLoadedConnection(
+	conns []conn.Conn, allowBanned bool,
+) (c conn.Conn, failedConns int) {
+	connCount := len(conns)
+	if connCount < 2 {
+		return s.selectRandomConnection(conns, allowBanned)
+	}
+
+	i := s.rand.Int(connCount)
+	j := s.rand.Int(connCount - 1)
+	if j >= i {
+		j++
+	}
+
+	first, second := conns[i], conns[j]
+	firstOk, secondOk := isOkConnection(first, allowBanned), isOkConnection(second, allowBanned)
+	switch {
+	case firstOk && secondOk:
+		if s.loads.score(second) < s.loads.score(first) {
+			return second, 0
+		}
+		return first, 0
+	case firstOk:
+		return first, 0
+	case secondOk:
+		return second, 0
+	default:
+		return s.selectRandomConnection(conns, allowBanned)
+	}
+}
+
 func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
 	connCount := len(conns)
 	if connCount == 0 {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -451,3 +452,70 @@ func TestConnection(t *testing.T) {
 		require.Equal(t, 0, failed)
 	})
 }
+
+func TestSelectLeastLoadedConnection(t *testing.T) {
+	s := newConnectionsState(nil, nil, balancerConfig.Info{}, false)
+	s.loads = newConnLoads()
+
+	t.Run("LoadFactor", func(t *testing.T) {
+		conns := []conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, LoadFactorField: 0},
+			&mock.Conn{AddrField: "2", State: conn.Online, LoadFactorField: 10},
+		}
+		for i := 0; i < 100; i++ {
+			c, failedCount := s.selectConnection(conns, false)
+			require.Equal(t, "1", c.Endpoint().Address())
+			require.Equal(t, 0, failedCount)
+		}
+	})
+	t.Run("InFlight", func(t *testing.T) {
+		conns := []conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online},
+			&mock.Conn{AddrField: "2", State: conn.Online},
+		}
+		s.loads.get(conns[0]).start()
+		defer s.loads.get(conns[0]).done(0)
+		for i := 0; i < 100; i++ {
+			c, _ := s.selectConnection(conns, false)
+			require.Equal(t, "2", c.Endpoint().Address())
+		}
+	})
+	t.Run("Latency", func(t *testing.T) {
+		conns := []conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online},
+			&mock.Conn{AddrField: "2", State: conn.Online},
+		}
+		for _, c := range conns {
+			s.loads.get(c).start()
+		}
+		s.loads.get(conns[0]).done(time.Second)
+		s.loads.get(conns[1]).done(time.Millisecond)
+		for i := 0; i < 100; i++ {
+			c, _ := s.selectConnection(conns, false)
+			require.Equal(t, "2", c.Endpoint().Address())
+		}
+	})
+	t.Run("Banned", func(t *testing.T) {
+		conns := []conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Banned},
+			&mock.Conn{AddrField: "2", State: conn.Online, LoadFactorField: 10},
+		}
+		for i := 0; i < 100; i++ {
+			c, _ := s.selectConnection(conns, false)
+			require.Equal(t, "2", c.Endpoint().Address())
+		}
+	})
+	t.Run("Retain", func(t *testing.T) {
+		conns := []conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online},
+			&mock.Conn{AddrField: "2", State: conn.Online},
+		}
+		loads := newConnLoads()
+		loads.get(conns[0])
+		loads.get(conns[1])
+		loads.retain(conns[1:])
+		require.Len(t, loads.loads, 1)
+		_, has := loads.loads[conns[1]]
+		require.True(t, has)
+	})
+}
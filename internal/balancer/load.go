@@ -0,0 +1,104 @@
+package balancer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+)
+
+const (
+	// latencyEWMAWeight is a weight of new observed latency in latency EWMA
+	latencyEWMAWeight = 0.3
+
+	// defaultLatency uses as latency of connection without observed calls
+	defaultLatency = time.Millisecond
+)
+
+// connLoad is a load statistics of one connection
+type connLoad struct {
+	mu       sync.Mutex
+	inflight int
+	latency  float64 // EWMA of calls latency in nanoseconds
+}
+
+func (l *connLoad) start() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inflight++
+}
+
+func (l *connLoad) done(latency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inflight--
+	if l.latency == 0 {
+		l.latency = float64(latency)
+	} else {
+		l.latency = latencyEWMAWeight*float64(latency) + (1-latencyEWMAWeight)*l.latency
+	}
+}
+
+func (l *connLoad) stats() (inflight int, latency float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.inflight, l.latency
+}
+
+// connLoads tracks load statistics of connections for least loaded balancing
+type connLoads struct {
+	mu    sync.Mutex
+	loads map[conn.Conn]*connLoad
+}
+
+func newConnLoads() *connLoads {
+	return &connLoads{
+		loads: make(map[conn.Conn]*connLoad),
+	}
+}
+
+func (l *connLoads) get(c conn.Conn) *connLoad {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	load, has := l.loads[c]
+	if !has {
+		load = &connLoad{}
+		l.loads[c] = load
+	}
+	return load
+}
+
+// retain removes statistics of connections which are not in conns
+func (l *connLoads) retain(conns []conn.Conn) {
+	actual := make(map[conn.Conn]struct{}, len(conns))
+	for _, c := range conns {
+		actual[c] = struct{}{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for c := range l.loads {
+		if _, has := actual[c]; !has {
+			delete(l.loads, c)
+		}
+	}
+}
+
+// score returns estimated cost of call on connection. Less is better.
+// Score combines in-flight calls count, latency EWMA and load factor of endpoint from discovery
+func (l *connLoads) score(c conn.Conn) float64 {
+	inflight, latency := l.get(c).stats()
+	if latency == 0 {
+		latency = float64(defaultLatency)
+	}
+	loadFactor := float64(c.Endpoint().LoadFactor())
+	if loadFactor < 0 {
+		loadFactor = 0
+	}
+	return float64(inflight+1) * latency * (1 + loadFactor)
+}
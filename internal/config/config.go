@@ -2,6 +2,9 @@ package config
 
 import (
 	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 type Common struct {
@@ -10,6 +13,8 @@ type Common struct {
 	disableAutoRetry     bool
 
 	panicCallback func(e interface{})
+	retryBudget   budget.Budget
+	retryTrace    *trace.Retry
 }
 
 // AutoRetry defines auto-retry flag
@@ -23,6 +28,20 @@ func (c *Common) PanicCallback() func(e interface{}) {
 	return c.panicCallback
 }
 
+// RetryBudget returns budget of retry attempts which shared between all retry loops of driver
+// If nil - retry attempts are not limited by budget
+func (c *Common) RetryBudget() budget.Budget {
+	return c.retryBudget
+}
+
+// RetryTrace returns trace of retry loops which shared between all retry loops of driver
+func (c *Common) RetryTrace() *trace.Retry {
+	if c.retryTrace == nil {
+		return &trace.Retry{}
+	}
+	return c.retryTrace
+}
+
 // OperationTimeout is the maximum amount of time a YDB server will process
 // an operation. After timeout exceeds YDB will try to cancel operation and
 // regardless of the cancellation appropriate error will be returned to
@@ -70,3 +89,13 @@ func SetPanicCallback(c *Common, panicCallback func(e interface{})) {
 func SetAutoRetry(c *Common, autoRetry bool) {
 	c.disableAutoRetry = !autoRetry
 }
+
+// SetRetryBudget applies budget of retry attempts to config
+func SetRetryBudget(c *Common, b budget.Budget) {
+	c.retryBudget = b
+}
+
+// SetRetryTrace appends trace of retry loops to config
+func SetRetryTrace(c *Common, t *trace.Retry, opts ...trace.RetryComposeOption) {
+	c.retryTrace = c.RetryTrace().Compose(t, opts...)
+}
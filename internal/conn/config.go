@@ -13,4 +13,8 @@ type Config interface {
 	Trace() *trace.Driver
 	ConnectionTTL() time.Duration
 	GrpcDialOptions() []grpc.DialOption
+
+	// CircuitBreaker reports about circuit breaker settings.
+	// Connections are not banned by pool on transport errors if circuit breaker is enabled
+	CircuitBreaker() (failures int, cooldown time.Duration)
 }
@@ -45,12 +45,15 @@ func (p *Pool) Get(endpoint endpoint.Endpoint) Conn {
 		return cc
 	}
 
-	cc = newConn(
-		endpoint,
-		p.config,
+	opts := []option{
 		withOnClose(p.remove),
-		withOnTransportError(p.Ban),
-	)
+	}
+	// with enabled circuit breaker connections are banned by balancer after threshold of failures
+	if failures, _ := p.config.CircuitBreaker(); failures <= 0 {
+		opts = append(opts, withOnTransportError(p.Ban))
+	}
+
+	cc = newConn(endpoint, p.config, opts...)
 
 	p.conns[key] = cc
 
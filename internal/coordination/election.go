@@ -0,0 +1,302 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// observeRetryDelay is a delay between attempts to watch the election semaphore.
+// The ephemeral semaphore does not exist while there are no candidates.
+const observeRetryDelay = 500 * time.Millisecond
+
+var (
+	errLeaderNotOwner = xerrors.Wrap(errors.New("elected session is not an owner of the election semaphore"))
+	errClosedElection = xerrors.Wrap(errors.New("election closed"))
+)
+
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+type election struct {
+	client *Client
+	path   string
+	name   string
+	opts   []options.SessionOption
+
+	sessionMutex sync.Mutex
+	session      coordination.Session
+
+	campaignMutex sync.Mutex
+
+	mutex  sync.Mutex
+	lease  coordination.Lease
+	leader *coordination.Leader
+	closed bool
+}
+
+func (c *Client) Election(path, name string, opts ...options.SessionOption) coordination.Election {
+	return &election{
+		client: c,
+		path:   path,
+		name:   name,
+		opts:   opts,
+	}
+}
+
+// getSession returns the session of the election and starts a new one if the previous session was lost
+func (e *election) getSession(ctx context.Context) (coordination.Session, error) {
+	e.sessionMutex.Lock()
+	defer e.sessionMutex.Unlock()
+
+	if e.isClosed() {
+		return nil, xerrors.WithStackTrace(errClosedElection)
+	}
+	if e.session != nil && e.session.Context().Err() == nil {
+		return e.session, nil
+	}
+
+	s, err := e.client.Session(ctx, e.path, e.opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	e.session = s
+
+	return s, nil
+}
+
+func (e *election) Campaign(ctx context.Context, data []byte) (leader *coordination.Leader, err error) {
+	onDone := trace.CoordinationOnElectionCampaign(e.client.config.Trace(), &ctx, e.path, e.name)
+	defer func() {
+		if leader != nil {
+			onDone(leader.SessionID, leader.OrderID, err)
+		} else {
+			onDone(0, 0, err)
+		}
+	}()
+
+	e.campaignMutex.Lock()
+	defer e.campaignMutex.Unlock()
+
+	e.mutex.Lock()
+	if e.lease != nil && e.lease.Context().Err() == nil {
+		leader = e.leader
+		e.mutex.Unlock()
+		return leader, nil
+	}
+	e.mutex.Unlock()
+
+	for {
+		s, err := e.getSession(ctx)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		lease, err := s.AcquireSemaphore(ctx, e.name, math.MaxUint64,
+			options.WithEphemeral(true),
+			options.WithAcquireData(data),
+		)
+		if err != nil {
+			if ctx.Err() == nil && s.Context().Err() != nil {
+				// the session was lost while waiting, campaign on a new session
+				continue
+			}
+			return nil, xerrors.WithStackTrace(err)
+		}
+		leader, err = e.ownLeader(ctx, s)
+		if err != nil {
+			_ = lease.Release(ctx)
+			if ctx.Err() == nil && s.Context().Err() != nil {
+				continue
+			}
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		e.mutex.Lock()
+		if e.closed {
+			// the session was closed by Close while campaign, the lease must not outlive the election
+			e.mutex.Unlock()
+			_ = lease.Release(ctx)
+			return nil, xerrors.WithStackTrace(errClosedElection)
+		}
+		e.lease = lease
+		e.leader = leader
+		e.mutex.Unlock()
+
+		go e.watchLeadership(lease, leader.SessionID)
+
+		return leader, nil
+	}
+}
+
+// ownLeader describes the election semaphore acquired by the session s
+func (e *election) ownLeader(ctx context.Context, s coordination.Session) (*coordination.Leader, error) {
+	description, err := s.DescribeSemaphore(ctx, e.name, options.WithDescribeOwners(true))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	for _, owner := range description.Owners {
+		if owner.SessionID == s.SessionID() {
+			return leaderFromOwner(owner), nil
+		}
+	}
+	return nil, xerrors.WithStackTrace(errLeaderNotOwner)
+}
+
+func (e *election) isClosed() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.closed
+}
+
+func (e *election) watchLeadership(lease coordination.Lease, sessionID uint64) {
+	<-lease.Context().Done()
+
+	e.mutex.Lock()
+	resigned := e.lease != lease
+	if !resigned {
+		e.lease = nil
+		e.leader = nil
+	}
+	e.mutex.Unlock()
+
+	if !resigned {
+		trace.CoordinationOnElectionLeadershipLost(e.client.config.Trace(), e.path, e.name, sessionID)
+	}
+}
+
+func (e *election) Resign(ctx context.Context) (err error) {
+	onDone := trace.CoordinationOnElectionResign(e.client.config.Trace(), &ctx, e.path, e.name)
+	defer func() {
+		onDone(err)
+	}()
+
+	e.mutex.Lock()
+	lease := e.lease
+	e.lease = nil
+	e.leader = nil
+	e.mutex.Unlock()
+
+	if lease == nil {
+		return nil
+	}
+	return xerrors.WithStackTrace(lease.Release(ctx))
+}
+
+func (e *election) Leader(ctx context.Context) (*coordination.Leader, error) {
+	s, err := e.getSession(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	description, err := s.DescribeSemaphore(ctx, e.name, options.WithDescribeOwners(true))
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			return nil, xerrors.WithStackTrace(coordination.ErrNoLeader)
+		}
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if len(description.Owners) == 0 {
+		return nil, xerrors.WithStackTrace(coordination.ErrNoLeader)
+	}
+	return leaderFromOwner(description.Owners[0]), nil
+}
+
+func (e *election) Observe(ctx context.Context) <-chan coordination.Leader {
+	ch := make(chan coordination.Leader, 1)
+	go func() {
+		defer close(ch)
+
+		// first attempt starts without delay
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		var last *coordination.Leader
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			s, err := e.getSession(ctx)
+			if xerrors.Is(err, errClosedElection) {
+				return
+			}
+			if err == nil {
+				descriptions, err := s.WatchSemaphore(ctx, e.name, options.WithDescribeOwners(true))
+				if err == nil {
+					for description := range descriptions {
+						if len(description.Owners) == 0 {
+							continue
+						}
+						leader := leaderFromOwner(description.Owners[0])
+						if last != nil && last.SessionID == leader.SessionID && last.OrderID == leader.OrderID {
+							continue
+						}
+						last = leader
+						trace.CoordinationOnElectionLeaderChanged(e.client.config.Trace(),
+							e.path, e.name, leader.SessionID, leader.OrderID,
+						)
+						select {
+						case ch <- *leader:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}
+			}
+
+			// the election semaphore does not exist without candidates or the session was lost
+			timer.Reset(observeRetryDelay)
+		}
+	}()
+	return ch
+}
+
+func (e *election) Done() <-chan struct{} {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.lease == nil {
+		return closedChan
+	}
+	return e.lease.Context().Done()
+}
+
+func (e *election) Close(ctx context.Context) error {
+	e.mutex.Lock()
+	e.closed = true
+	e.lease = nil
+	e.leader = nil
+	e.mutex.Unlock()
+
+	e.sessionMutex.Lock()
+	s := e.session
+	e.session = nil
+	e.sessionMutex.Unlock()
+
+	if s == nil {
+		return nil
+	}
+	return xerrors.WithStackTrace(s.Close(ctx))
+}
+
+func leaderFromOwner(owner *coordination.SemaphoreSession) *coordination.Leader {
+	return &coordination.Leader{
+		SessionID: owner.SessionID,
+		OrderID:   owner.OrderID,
+		Data:      owner.Data,
+	}
+}
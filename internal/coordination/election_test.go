@@ -0,0 +1,114 @@
+package coordination
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestElection(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := newFakeCoordinationService()
+
+	var elected, resigned int
+	client := newTestClient(service)
+	client.config.Trace().OnElectionCampaign = func(
+		trace.CoordinationElectionCampaignStartInfo,
+	) func(
+		trace.CoordinationElectionCampaignDoneInfo,
+	) {
+		return func(info trace.CoordinationElectionCampaignDoneInfo) {
+			if info.Error == nil {
+				elected++
+			}
+		}
+	}
+	client.config.Trace().OnElectionResign = func(
+		trace.CoordinationElectionResignStartInfo,
+	) func(
+		trace.CoordinationElectionResignDoneInfo,
+	) {
+		return func(info trace.CoordinationElectionResignDoneInfo) {
+			resigned++
+		}
+	}
+
+	e := client.Election("/local/node", "leader")
+
+	_, err := e.Leader(ctx)
+	require.True(t, errors.Is(err, coordination.ErrNoLeader))
+
+	select {
+	case <-e.Done():
+	default:
+		t.Fatal("done channel of not a leader must be closed")
+	}
+
+	leader, err := e.Campaign(ctx, []byte("me"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), leader.SessionID)
+	require.Equal(t, uint64(1), leader.OrderID)
+	require.Equal(t, []byte("me"), leader.Data)
+	require.Equal(t, 1, elected)
+
+	current, err := e.Leader(ctx)
+	require.NoError(t, err)
+	require.Equal(t, leader, current)
+
+	done := e.Done()
+	select {
+	case <-done:
+		t.Fatal("done channel of the leader must not be closed")
+	default:
+	}
+
+	require.NoError(t, e.Resign(ctx))
+	require.Equal(t, 1, resigned)
+	<-done
+
+	_, err = e.Leader(ctx)
+	require.True(t, errors.Is(err, coordination.ErrNoLeader))
+
+	require.NoError(t, e.Close(ctx))
+}
+
+func TestElectionClose(t *testing.T) {
+	ctx := xtest.Context(t)
+
+	t.Run("Closed", func(t *testing.T) {
+		e := newTestClient(newFakeCoordinationService()).Election("/local/node", "leader")
+		observed := e.Observe(ctx)
+		require.NoError(t, e.Close(ctx))
+
+		_, err := e.Campaign(ctx, nil)
+		require.ErrorIs(t, err, errClosedElection)
+		_, err = e.Leader(ctx)
+		require.ErrorIs(t, err, errClosedElection)
+		_, ok := <-observed
+		require.False(t, ok)
+	})
+	t.Run("ConcurrentCampaign", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			e := newTestClient(newFakeCoordinationService()).Election("/local/node", "leader")
+			campaignDone := make(chan struct{})
+			go func() {
+				defer close(campaignDone)
+				_, _ = e.Campaign(ctx, nil)
+			}()
+			require.NoError(t, e.Close(ctx))
+			<-campaignDone
+
+			// campaign must not keep leadership on the discarded session
+			select {
+			case <-e.Done():
+			default:
+				t.Fatal("election keeps leadership after close")
+			}
+		}
+	})
+}
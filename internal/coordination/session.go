@@ -0,0 +1,885 @@
+package coordination
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Coordination_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const protectionKeySize = 16
+
+var (
+	errSessionStartTimeout    = xerrors.Wrap(errors.New("coordination session start timeout"))
+	errSessionStopped         = xerrors.Wrap(errors.New("coordination session stopped by server"))
+	errSessionExpired         = xerrors.Wrap(errors.New("coordination session expired"))
+	errUnexpectedResponse     = xerrors.Wrap(errors.New("unexpected coordination session response"))
+	errSessionAlreadyStopping = xerrors.Wrap(errors.New("coordination session already stopping"))
+)
+
+type sessionStream = Ydb_Coordination_V1.CoordinationService_SessionClient
+
+// call is a request of the session which waits for responses from the server.
+// Calls which are not answered yet are resent after the stream reconnect.
+type call struct {
+	id        uint64
+	request   *Ydb_Coordination.SessionRequest
+	responses chan *Ydb_Coordination.SessionResponse
+}
+
+type session struct {
+	client  *Client
+	path    string
+	options *options.CreateSessionOptions
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	seqNo         uint64
+	protectionKey []byte
+
+	mutex     sync.Mutex
+	sessionID uint64
+	stream    sessionStream
+	lastReqID uint64
+	calls     map[uint64]*call
+	stopping  bool
+	err       error
+}
+
+func (c *Client) Session(
+	ctx context.Context,
+	path string,
+	opts ...options.SessionOption,
+) (_ coordination.Session, err error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	var s *session
+	call := func(ctx context.Context) (err error) {
+		s, err = newSession(ctx, c, path, options.NewCreateSessionOptions(opts...))
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		err = call(ctx)
+	} else {
+		err = retry.Retry(ctx, call, retry.WithStackTrace(), retry.WithIdempotent(true))
+	}
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return s, nil
+}
+
+func newSession(
+	ctx context.Context,
+	client *Client,
+	path string,
+	opts *options.CreateSessionOptions,
+) (*session, error) {
+	s := &session{
+		client:        client,
+		path:          path,
+		options:       opts,
+		done:          make(chan struct{}),
+		protectionKey: make([]byte, protectionKeySize),
+		calls:         make(map[uint64]*call),
+	}
+	if _, err := rand.Read(s.protectionKey); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	s.ctx, s.cancel = xcontext.WithCancel(xcontext.WithoutDeadline(ctx))
+
+	stream, streamCancel, err := s.startStream(ctx)
+	if err != nil {
+		s.cancel()
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	go s.mainLoop(stream, streamCancel)
+
+	return s, nil
+}
+
+func (s *session) Context() context.Context {
+	return s.ctx
+}
+
+func (s *session) SessionID() uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.sessionID
+}
+
+// startStream opens a new gRPC stream and attaches the session to it
+func (s *session) startStream(ctx context.Context) (_ sessionStream, _ context.CancelFunc, err error) {
+	s.mutex.Lock()
+	sessionID := s.sessionID
+	s.mutex.Unlock()
+
+	onDone := trace.CoordinationOnSessionStart(s.client.config.Trace(), &ctx, s.path, sessionID)
+	defer func() {
+		onDone(sessionID, err)
+	}()
+
+	streamCtx, streamCancel := xcontext.WithCancel(xcontext.WithoutDeadline(s.ctx))
+	defer func() {
+		if err != nil {
+			streamCancel()
+		}
+	}()
+
+	stream, err := s.client.service.Session(streamCtx)
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	s.seqNo++
+	err = stream.Send(&Ydb_Coordination.SessionRequest{
+		Request: &Ydb_Coordination.SessionRequest_SessionStart_{
+			SessionStart: &Ydb_Coordination.SessionRequest_SessionStart{
+				Path:          s.path,
+				SessionId:     sessionID,
+				TimeoutMillis: uint64(s.options.SessionTimeout.Milliseconds()),
+				Description:   s.options.Description,
+				SeqNo:         s.seqNo,
+				ProtectionKey: s.protectionKey,
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	type startResult struct {
+		sessionID uint64
+		err       error
+	}
+	started := make(chan startResult, 1)
+	go func() {
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				started <- startResult{err: xerrors.WithStackTrace(err)}
+				return
+			}
+			switch r := response.GetResponse().(type) {
+			case *Ydb_Coordination.SessionResponse_Ping:
+				err = stream.Send(pong(r.Ping.GetOpaque()))
+				if err != nil {
+					started <- startResult{err: xerrors.WithStackTrace(err)}
+					return
+				}
+			case *Ydb_Coordination.SessionResponse_Failure_:
+				started <- startResult{
+					err: xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(r.Failure))),
+				}
+				return
+			case *Ydb_Coordination.SessionResponse_SessionStarted_:
+				started <- startResult{sessionID: r.SessionStarted.GetSessionId()}
+				return
+			default:
+				started <- startResult{err: xerrors.WithStackTrace(errUnexpectedResponse)}
+				return
+			}
+		}
+	}()
+
+	timer := time.NewTimer(s.options.SessionStartTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, xerrors.WithStackTrace(ctx.Err())
+	case <-timer.C:
+		return nil, nil, xerrors.WithStackTrace(errSessionStartTimeout)
+	case result := <-started:
+		if result.err != nil {
+			return nil, nil, xerrors.WithStackTrace(result.err)
+		}
+		sessionID = result.sessionID
+	}
+
+	s.mutex.Lock()
+	s.sessionID = sessionID
+	s.mutex.Unlock()
+
+	return stream, streamCancel, nil
+}
+
+// mainLoop serves the stream and reconnects it until the session is stopped or lost
+func (s *session) mainLoop(stream sessionStream, streamCancel context.CancelFunc) {
+	defer close(s.done)
+
+	lastActivity := time.Now()
+	for {
+		if stream != nil {
+			var err error
+			lastActivity, err = s.serve(stream, streamCancel)
+			if errors.Is(err, errSessionStopped) {
+				s.finish(xerrors.WithStackTrace(coordination.ErrSessionClosed))
+				return
+			}
+			if isSessionLost(err) {
+				s.lost(err)
+				return
+			}
+		}
+
+		if s.ctx.Err() != nil || s.isStopping() {
+			s.finish(xerrors.WithStackTrace(coordination.ErrSessionClosed))
+			return
+		}
+
+		if time.Since(lastActivity) > s.options.SessionTimeout {
+			s.lost(xerrors.WithStackTrace(errSessionExpired))
+			return
+		}
+
+		delay := time.NewTimer(s.options.SessionReconnectDelay)
+		select {
+		case <-s.ctx.Done():
+			delay.Stop()
+			s.finish(xerrors.WithStackTrace(coordination.ErrSessionClosed))
+			return
+		case <-delay.C:
+		}
+
+		ctx, cancel := xcontext.WithTimeout(s.ctx, s.options.SessionStartTimeout)
+		var err error
+		stream, streamCancel, err = s.startStream(ctx)
+		cancel()
+		if err != nil {
+			if isSessionLost(err) {
+				s.lost(err)
+				return
+			}
+			stream = nil
+			continue
+		}
+		lastActivity = time.Now()
+	}
+}
+
+// serve resends not answered calls into the stream and dispatches responses
+// until the stream breaks. It returns the time of the last response from the server.
+func (s *session) serve(
+	stream sessionStream,
+	streamCancel context.CancelFunc,
+) (lastActivity time.Time, err error) {
+	defer streamCancel()
+
+	s.mutex.Lock()
+	s.stream = stream
+	if s.stopping {
+		_ = stream.Send(stop())
+	}
+	for _, c := range s.pendingCalls() {
+		_ = stream.Send(c.request)
+	}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		s.stream = nil
+		s.mutex.Unlock()
+	}()
+
+	var lastRecv atomic.Int64
+	lastRecv.Store(time.Now().UnixNano())
+
+	keepAliveDone := make(chan struct{})
+	defer close(keepAliveDone)
+
+	go s.keepAlive(stream, streamCancel, &lastRecv, keepAliveDone)
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return time.Unix(0, lastRecv.Load()), xerrors.WithStackTrace(err)
+		}
+		lastRecv.Store(time.Now().UnixNano())
+
+		switch r := response.GetResponse().(type) {
+		case *Ydb_Coordination.SessionResponse_Ping:
+			s.send(stream, pong(r.Ping.GetOpaque()))
+		case *Ydb_Coordination.SessionResponse_Pong:
+		case *Ydb_Coordination.SessionResponse_SessionStarted_:
+		case *Ydb_Coordination.SessionResponse_Failure_:
+			return time.Unix(0, lastRecv.Load()), xerrors.WithStackTrace(
+				xerrors.Operation(xerrors.FromOperation(r.Failure)),
+			)
+		case *Ydb_Coordination.SessionResponse_SessionStopped_:
+			return time.Unix(0, lastRecv.Load()), xerrors.WithStackTrace(errSessionStopped)
+		case *Ydb_Coordination.SessionResponse_AcquireSemaphorePending_:
+		case *Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_:
+			s.dispatch(r.DescribeSemaphoreResult.GetReqId(), response, !r.DescribeSemaphoreResult.GetWatchAdded())
+		case *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_:
+			s.dispatch(r.DescribeSemaphoreChanged.GetReqId(), response, true)
+		case *Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_:
+			s.dispatch(r.AcquireSemaphoreResult.GetReqId(), response, true)
+		case *Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult_:
+			s.dispatch(r.ReleaseSemaphoreResult.GetReqId(), response, true)
+		case *Ydb_Coordination.SessionResponse_CreateSemaphoreResult_:
+			s.dispatch(r.CreateSemaphoreResult.GetReqId(), response, true)
+		case *Ydb_Coordination.SessionResponse_UpdateSemaphoreResult_:
+			s.dispatch(r.UpdateSemaphoreResult.GetReqId(), response, true)
+		case *Ydb_Coordination.SessionResponse_DeleteSemaphoreResult_:
+			s.dispatch(r.DeleteSemaphoreResult.GetReqId(), response, true)
+		}
+	}
+}
+
+// keepAlive pings the server and breaks the stream if the server does not respond
+// during keep alive timeout
+func (s *session) keepAlive(
+	stream sessionStream,
+	streamCancel context.CancelFunc,
+	lastRecv *atomic.Int64,
+	done <-chan struct{},
+) {
+	ticker := time.NewTicker(s.options.SessionKeepAliveTimeout / 4) //nolint:gomnd
+	defer ticker.Stop()
+
+	var opaque uint64
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if time.Since(time.Unix(0, lastRecv.Load())) > s.options.SessionKeepAliveTimeout {
+				trace.CoordinationOnSessionKeepAliveTimeout(s.client.config.Trace(), s.SessionID())
+				streamCancel()
+				return
+			}
+			opaque++
+			s.send(stream, ping(opaque))
+		}
+	}
+}
+
+func (s *session) send(stream sessionStream, request *Ydb_Coordination.SessionRequest) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// send errors are detected by the receive loop of the stream
+	_ = stream.Send(request)
+}
+
+// pendingCalls returns not answered calls in order of their creation. Must be called under s.mutex.
+func (s *session) pendingCalls() []*call {
+	calls := make([]*call, 0, len(s.calls))
+	for _, c := range s.calls {
+		calls = append(calls, c)
+	}
+	sort.Slice(calls, func(i, j int) bool {
+		return calls[i].id < calls[j].id
+	})
+	return calls
+}
+
+func (s *session) isStopping() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.stopping
+}
+
+// newCall registers a new call and sends its request if the stream is connected
+func (s *session) newCall(
+	makeRequest func(reqID uint64) *Ydb_Coordination.SessionRequest,
+	responses int,
+) (*call, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return nil, xerrors.WithStackTrace(s.err)
+	}
+
+	s.lastReqID++
+	c := &call{
+		id:        s.lastReqID,
+		request:   makeRequest(s.lastReqID),
+		responses: make(chan *Ydb_Coordination.SessionResponse, responses),
+	}
+	s.calls[c.id] = c
+
+	if s.stream != nil {
+		_ = s.stream.Send(c.request)
+	}
+
+	return c, nil
+}
+
+func (s *session) forget(c *call) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.calls, c.id)
+}
+
+func (s *session) dispatch(reqID uint64, response *Ydb_Coordination.SessionResponse, final bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	c, has := s.calls[reqID]
+	if !has {
+		return
+	}
+	if final {
+		delete(s.calls, reqID)
+	}
+	select {
+	case c.responses <- response:
+	default:
+	}
+}
+
+func (s *session) wait(ctx context.Context, c *call) (*Ydb_Coordination.SessionResponse, error) {
+	select {
+	case response := <-c.responses:
+		return response, nil
+	case <-ctx.Done():
+		s.forget(c)
+		return nil, xerrors.WithStackTrace(ctx.Err())
+	case <-s.ctx.Done():
+		return nil, xerrors.WithStackTrace(s.closeReason())
+	}
+}
+
+func (s *session) closeReason() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+	return coordination.ErrSessionClosed
+}
+
+// finish cancels the session context and fails all not answered calls
+func (s *session) finish(err error) {
+	s.mutex.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.calls = make(map[uint64]*call)
+	s.mutex.Unlock()
+
+	s.cancel()
+}
+
+func (s *session) lost(err error) {
+	trace.CoordinationOnSessionLost(s.client.config.Trace(), s.SessionID(), err)
+	s.finish(err)
+}
+
+func (s *session) Close(ctx context.Context) (err error) {
+	onDone := trace.CoordinationOnSessionStop(s.client.config.Trace(), &ctx, s.SessionID())
+	defer func() {
+		onDone(err)
+	}()
+
+	s.mutex.Lock()
+	if s.stopping || s.err != nil {
+		s.mutex.Unlock()
+		return xerrors.WithStackTrace(errSessionAlreadyStopping)
+	}
+	s.stopping = true
+	if s.stream != nil {
+		_ = s.stream.Send(stop())
+	}
+	s.mutex.Unlock()
+
+	timer := time.NewTimer(s.options.SessionStopTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-s.done:
+	case <-timer.C:
+	case <-ctx.Done():
+		err = xerrors.WithStackTrace(ctx.Err())
+	}
+
+	s.finish(xerrors.WithStackTrace(coordination.ErrSessionClosed))
+	<-s.done
+
+	return err
+}
+
+func (s *session) CreateSemaphore(
+	ctx context.Context,
+	name string,
+	limit uint64,
+	opts ...options.CreateSemaphoreOption,
+) error {
+	c, err := s.newCall(func(reqID uint64) *Ydb_Coordination.SessionRequest {
+		request := &Ydb_Coordination.SessionRequest_CreateSemaphore{
+			ReqId: reqID,
+			Name:  name,
+			Limit: limit,
+		}
+		for _, o := range opts {
+			if o != nil {
+				o(request)
+			}
+		}
+		return &Ydb_Coordination.SessionRequest{
+			Request: &Ydb_Coordination.SessionRequest_CreateSemaphore_{CreateSemaphore: request},
+		}
+	}, 1)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	response, err := s.wait(ctx, c)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return checkStatus(response.GetCreateSemaphoreResult())
+}
+
+func (s *session) UpdateSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.UpdateSemaphoreOption,
+) error {
+	c, err := s.newCall(func(reqID uint64) *Ydb_Coordination.SessionRequest {
+		request := &Ydb_Coordination.SessionRequest_UpdateSemaphore{
+			ReqId: reqID,
+			Name:  name,
+		}
+		for _, o := range opts {
+			if o != nil {
+				o(request)
+			}
+		}
+		return &Ydb_Coordination.SessionRequest{
+			Request: &Ydb_Coordination.SessionRequest_UpdateSemaphore_{UpdateSemaphore: request},
+		}
+	}, 1)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	response, err := s.wait(ctx, c)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return checkStatus(response.GetUpdateSemaphoreResult())
+}
+
+func (s *session) DeleteSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DeleteSemaphoreOption,
+) error {
+	c, err := s.newCall(func(reqID uint64) *Ydb_Coordination.SessionRequest {
+		request := &Ydb_Coordination.SessionRequest_DeleteSemaphore{
+			ReqId: reqID,
+			Name:  name,
+		}
+		for _, o := range opts {
+			if o != nil {
+				o(request)
+			}
+		}
+		return &Ydb_Coordination.SessionRequest{
+			Request: &Ydb_Coordination.SessionRequest_DeleteSemaphore_{DeleteSemaphore: request},
+		}
+	}, 1)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	response, err := s.wait(ctx, c)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return checkStatus(response.GetDeleteSemaphoreResult())
+}
+
+func (s *session) describeSemaphore(
+	name string,
+	watch bool,
+	opts ...options.DescribeSemaphoreOption,
+) (*call, error) {
+	responses := 1
+	if watch {
+		// watched describe receives both the result and the change notification
+		responses = 2
+	}
+	return s.newCall(func(reqID uint64) *Ydb_Coordination.SessionRequest {
+		request := &Ydb_Coordination.SessionRequest_DescribeSemaphore{
+			ReqId:       reqID,
+			Name:        name,
+			WatchData:   watch,
+			WatchOwners: watch,
+		}
+		for _, o := range opts {
+			if o != nil {
+				o(request)
+			}
+		}
+		return &Ydb_Coordination.SessionRequest{
+			Request: &Ydb_Coordination.SessionRequest_DescribeSemaphore_{DescribeSemaphore: request},
+		}
+	}, responses)
+}
+
+func (s *session) DescribeSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, error) {
+	c, err := s.describeSemaphore(name, false, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	response, err := s.wait(ctx, c)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	result := response.GetDescribeSemaphoreResult()
+	if err = checkStatus(result); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return semaphoreDescription(result.GetSemaphoreDescription()), nil
+}
+
+func (s *session) WatchSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (<-chan *coordination.SemaphoreDescription, error) {
+	describe := func() (*call, *Ydb_Coordination.SessionResponse_DescribeSemaphoreResult, error) {
+		c, err := s.describeSemaphore(name, true, opts...)
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		response, err := s.wait(ctx, c)
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		result := response.GetDescribeSemaphoreResult()
+		if err = checkStatus(result); err != nil {
+			s.forget(c)
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		return c, result, nil
+	}
+
+	c, result, err := describe()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	ch := make(chan *coordination.SemaphoreDescription, 1)
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case ch <- semaphoreDescription(result.GetSemaphoreDescription()):
+			case <-ctx.Done():
+				s.forget(c)
+				return
+			case <-s.ctx.Done():
+				return
+			}
+			if !result.GetWatchAdded() {
+				return
+			}
+			response, err := s.wait(ctx, c)
+			if err != nil {
+				return
+			}
+			switch r := response.GetResponse().(type) {
+			case *Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_:
+				// the watch was re-established after the stream reconnect
+				if checkStatus(r.DescribeSemaphoreResult) != nil {
+					s.forget(c)
+					return
+				}
+				result = r.DescribeSemaphoreResult
+			case *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_:
+				c, result, err = describe()
+				if err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (s *session) AcquireSemaphore(
+	ctx context.Context,
+	name string,
+	count uint64,
+	opts ...options.AcquireSemaphoreOption,
+) (coordination.Lease, error) {
+	request := options.NewAcquireSemaphoreRequest(name, count, opts...)
+	c, err := s.newCall(func(reqID uint64) *Ydb_Coordination.SessionRequest {
+		request.ReqId = reqID
+		return &Ydb_Coordination.SessionRequest{
+			Request: &Ydb_Coordination.SessionRequest_AcquireSemaphore_{AcquireSemaphore: request},
+		}
+	}, 1)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	response, err := s.wait(ctx, c)
+	if err != nil {
+		if ctx.Err() != nil {
+			// cancel waiting in the semaphore queue (or release the semaphore acquired concurrently)
+			_, _ = s.releaseSemaphore(name)
+		}
+		return nil, xerrors.WithStackTrace(err)
+	}
+	result := response.GetAcquireSemaphoreResult()
+	if err = checkStatus(result); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if !result.GetAcquired() {
+		return nil, xerrors.WithStackTrace(coordination.ErrAcquireTimeout)
+	}
+	return newLease(s, name), nil
+}
+
+func (s *session) releaseSemaphore(name string) (*call, error) {
+	return s.newCall(func(reqID uint64) *Ydb_Coordination.SessionRequest {
+		return &Ydb_Coordination.SessionRequest{
+			Request: &Ydb_Coordination.SessionRequest_ReleaseSemaphore_{
+				ReleaseSemaphore: &Ydb_Coordination.SessionRequest_ReleaseSemaphore{
+					ReqId: reqID,
+					Name:  name,
+				},
+			},
+		}
+	}, 1)
+}
+
+type lease struct {
+	session *session
+	name    string
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+func newLease(s *session, name string) *lease {
+	ctx, cancel := xcontext.WithCancel(s.ctx)
+	return &lease{
+		session: s,
+		name:    name,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+func (l *lease) Context() context.Context {
+	return l.ctx
+}
+
+func (l *lease) Session() coordination.Session {
+	return l.session
+}
+
+func (l *lease) Release(ctx context.Context) error {
+	defer l.cancel()
+
+	c, err := l.session.releaseSemaphore(l.name)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	response, err := l.session.wait(ctx, c)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return checkStatus(response.GetReleaseSemaphoreResult())
+}
+
+type operationStatus interface {
+	GetStatus() Ydb.StatusIds_StatusCode
+	GetIssues() []*Ydb_Issue.IssueMessage
+}
+
+func checkStatus(result operationStatus) error {
+	if result.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(result)))
+	}
+	return nil
+}
+
+// isSessionLost reports whether the session cannot be restored after err
+func isSessionLost(err error) bool {
+	return xerrors.IsOperationError(err) && !retry.Check(err).MustRetry(true)
+}
+
+func semaphoreDescription(d *Ydb_Coordination.SemaphoreDescription) *coordination.SemaphoreDescription {
+	return &coordination.SemaphoreDescription{
+		Name:      d.GetName(),
+		Data:      d.GetData(),
+		Count:     d.GetCount(),
+		Limit:     d.GetLimit(),
+		Ephemeral: d.GetEphemeral(),
+		Owners:    semaphoreSessions(d.GetOwners()),
+		Waiters:   semaphoreSessions(d.GetWaiters()),
+	}
+}
+
+func semaphoreSessions(sessions []*Ydb_Coordination.SemaphoreSession) []*coordination.SemaphoreSession {
+	if sessions == nil {
+		return nil
+	}
+	result := make([]*coordination.SemaphoreSession, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, &coordination.SemaphoreSession{
+			SessionID: s.GetSessionId(),
+			OrderID:   s.GetOrderId(),
+			Timeout:   time.Duration(s.GetTimeoutMillis()) * time.Millisecond,
+			Count:     s.GetCount(),
+			Data:      s.GetData(),
+		})
+	}
+	return result
+}
+
+func ping(opaque uint64) *Ydb_Coordination.SessionRequest {
+	return &Ydb_Coordination.SessionRequest{
+		Request: &Ydb_Coordination.SessionRequest_Ping{
+			Ping: &Ydb_Coordination.SessionRequest_PingPong{Opaque: opaque},
+		},
+	}
+}
+
+func pong(opaque uint64) *Ydb_Coordination.SessionRequest {
+	return &Ydb_Coordination.SessionRequest{
+		Request: &Ydb_Coordination.SessionRequest_Pong{
+			Pong: &Ydb_Coordination.SessionRequest_PingPong{Opaque: opaque},
+		},
+	}
+}
+
+func stop() *Ydb_Coordination.SessionRequest {
+	return &Ydb_Coordination.SessionRequest{
+		Request: &Ydb_Coordination.SessionRequest_SessionStop_{
+			SessionStop: &Ydb_Coordination.SessionRequest_SessionStop{},
+		},
+	}
+}
@@ -0,0 +1,320 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Coordination_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// fakeSemaphore is a semaphore state of fakeCoordinationService
+type fakeSemaphore struct {
+	limit     uint64
+	count     uint64
+	data      []byte
+	ephemeral bool
+	owners    map[uint64]*Ydb_Coordination.SemaphoreSession
+}
+
+// fakeCoordinationService is an in-memory coordination node which serves a single session
+type fakeCoordinationService struct {
+	Ydb_Coordination_V1.CoordinationServiceClient
+
+	mu         sync.Mutex
+	semaphores map[string]*fakeSemaphore
+	lastOrder  uint64
+	streams    []*fakeSessionStream
+	// dropAcquire breaks the stream on the first acquire request instead of answering it
+	dropAcquire bool
+}
+
+func newFakeCoordinationService() *fakeCoordinationService {
+	return &fakeCoordinationService{
+		semaphores: make(map[string]*fakeSemaphore),
+	}
+}
+
+func (f *fakeCoordinationService) Session(
+	ctx context.Context, _ ...grpc.CallOption,
+) (Ydb_Coordination_V1.CoordinationService_SessionClient, error) {
+	stream := &fakeSessionStream{
+		ctx:       ctx,
+		service:   f,
+		responses: make(chan *Ydb_Coordination.SessionResponse, 100),
+		closed:    make(chan struct{}),
+	}
+	f.mu.Lock()
+	f.streams = append(f.streams, stream)
+	f.mu.Unlock()
+	return stream, nil
+}
+
+func (f *fakeCoordinationService) handle(
+	s *fakeSessionStream,
+	request *Ydb_Coordination.SessionRequest,
+) *Ydb_Coordination.SessionResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r := request.GetRequest().(type) {
+	case *Ydb_Coordination.SessionRequest_SessionStart_:
+		return &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_SessionStarted_{
+				SessionStarted: &Ydb_Coordination.SessionResponse_SessionStarted{SessionId: 42},
+			},
+		}
+	case *Ydb_Coordination.SessionRequest_SessionStop_:
+		return &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_SessionStopped_{
+				SessionStopped: &Ydb_Coordination.SessionResponse_SessionStopped{SessionId: 42},
+			},
+		}
+	case *Ydb_Coordination.SessionRequest_CreateSemaphore_:
+		status := Ydb.StatusIds_SUCCESS
+		if _, has := f.semaphores[r.CreateSemaphore.GetName()]; has {
+			status = Ydb.StatusIds_ALREADY_EXISTS
+		} else {
+			f.semaphores[r.CreateSemaphore.GetName()] = &fakeSemaphore{
+				limit:  r.CreateSemaphore.GetLimit(),
+				data:   r.CreateSemaphore.GetData(),
+				owners: make(map[uint64]*Ydb_Coordination.SemaphoreSession),
+			}
+		}
+		return &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_CreateSemaphoreResult_{
+				CreateSemaphoreResult: &Ydb_Coordination.SessionResponse_CreateSemaphoreResult{
+					ReqId:  r.CreateSemaphore.GetReqId(),
+					Status: status,
+				},
+			},
+		}
+	case *Ydb_Coordination.SessionRequest_AcquireSemaphore_:
+		if f.dropAcquire {
+			f.dropAcquire = false
+			s.breakStream()
+			return nil
+		}
+		result := &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+			ReqId:  r.AcquireSemaphore.GetReqId(),
+			Status: Ydb.StatusIds_SUCCESS,
+		}
+		semaphore, has := f.semaphores[r.AcquireSemaphore.GetName()]
+		if !has && r.AcquireSemaphore.GetEphemeral() {
+			semaphore = &fakeSemaphore{
+				limit:     math.MaxUint64,
+				ephemeral: true,
+				owners:    make(map[uint64]*Ydb_Coordination.SemaphoreSession),
+			}
+			f.semaphores[r.AcquireSemaphore.GetName()] = semaphore
+			has = true
+		}
+		switch {
+		case !has:
+			result.Status = Ydb.StatusIds_NOT_FOUND
+		case semaphore.limit-semaphore.count >= r.AcquireSemaphore.GetCount():
+			f.lastOrder++
+			semaphore.count += r.AcquireSemaphore.GetCount()
+			semaphore.owners[42] = &Ydb_Coordination.SemaphoreSession{
+				OrderId:   f.lastOrder,
+				SessionId: 42,
+				Count:     r.AcquireSemaphore.GetCount(),
+				Data:      r.AcquireSemaphore.GetData(),
+			}
+			result.Acquired = true
+		case r.AcquireSemaphore.GetTimeoutMillis() != 0:
+			// the session waits in the semaphore queue
+			return nil
+		}
+		return &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{AcquireSemaphoreResult: result},
+		}
+	case *Ydb_Coordination.SessionRequest_ReleaseSemaphore_:
+		result := &Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult{
+			ReqId:  r.ReleaseSemaphore.GetReqId(),
+			Status: Ydb.StatusIds_SUCCESS,
+		}
+		if semaphore, has := f.semaphores[r.ReleaseSemaphore.GetName()]; has {
+			if owner, has := semaphore.owners[42]; has {
+				semaphore.count -= owner.GetCount()
+				delete(semaphore.owners, 42)
+				result.Released = true
+			}
+			if semaphore.ephemeral && len(semaphore.owners) == 0 {
+				delete(f.semaphores, r.ReleaseSemaphore.GetName())
+			}
+		}
+		return &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_ReleaseSemaphoreResult_{ReleaseSemaphoreResult: result},
+		}
+	case *Ydb_Coordination.SessionRequest_DescribeSemaphore_:
+		semaphore, has := f.semaphores[r.DescribeSemaphore.GetName()]
+		if !has {
+			return &Ydb_Coordination.SessionResponse{
+				Response: &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_{
+					DescribeSemaphoreResult: &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult{
+						ReqId:  r.DescribeSemaphore.GetReqId(),
+						Status: Ydb.StatusIds_NOT_FOUND,
+					},
+				},
+			}
+		}
+		description := &Ydb_Coordination.SemaphoreDescription{
+			Name:  r.DescribeSemaphore.GetName(),
+			Limit: semaphore.limit,
+			Count: semaphore.count,
+			Data:  semaphore.data,
+		}
+		if r.DescribeSemaphore.GetIncludeOwners() {
+			for _, owner := range semaphore.owners {
+				description.Owners = append(description.Owners, owner)
+			}
+		}
+		return &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_{
+				DescribeSemaphoreResult: &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult{
+					ReqId:                r.DescribeSemaphore.GetReqId(),
+					Status:               Ydb.StatusIds_SUCCESS,
+					SemaphoreDescription: description,
+				},
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+type fakeSessionStream struct {
+	grpc.ClientStream
+
+	ctx       context.Context
+	service   *fakeCoordinationService
+	responses chan *Ydb_Coordination.SessionResponse
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func (s *fakeSessionStream) breakStream() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+}
+
+func (s *fakeSessionStream) Send(request *Ydb_Coordination.SessionRequest) error {
+	select {
+	case <-s.closed:
+		return io.EOF
+	default:
+	}
+	if response := s.service.handle(s, request); response != nil {
+		s.responses <- response
+	}
+	return nil
+}
+
+func (s *fakeSessionStream) Recv() (*Ydb_Coordination.SessionResponse, error) {
+	select {
+	case response := <-s.responses:
+		return response, nil
+	case <-s.closed:
+		return nil, io.EOF
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func newTestClient(service Ydb_Coordination_V1.CoordinationServiceClient) *Client {
+	return &Client{
+		config:  config.New(),
+		service: service,
+	}
+}
+
+func TestSessionSemaphore(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := newFakeCoordinationService()
+
+	s, err := newTestClient(service).Session(ctx, "/local/node")
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), s.SessionID())
+
+	require.NoError(t, s.CreateSemaphore(ctx, "lock", 1, options.WithCreateData([]byte("data"))))
+
+	lease, err := s.AcquireSemaphore(ctx, "lock", 1)
+	require.NoError(t, err)
+
+	_, err = s.AcquireSemaphore(ctx, "lock", 1, options.WithTryAcquire())
+	require.ErrorIs(t, err, coordination.ErrAcquireTimeout)
+
+	description, err := s.DescribeSemaphore(ctx, "lock", options.WithDescribeOwners(true))
+	require.NoError(t, err)
+	require.Equal(t, "lock", description.Name)
+	require.Equal(t, []byte("data"), description.Data)
+	require.Equal(t, uint64(1), description.Count)
+	require.Len(t, description.Owners, 1)
+	require.Equal(t, uint64(42), description.Owners[0].SessionID)
+
+	require.NoError(t, lease.Release(ctx))
+	require.Error(t, lease.Context().Err())
+
+	require.NoError(t, s.Close(ctx))
+	require.Error(t, s.Context().Err())
+
+	err = s.CreateSemaphore(ctx, "other", 1)
+	require.ErrorIs(t, err, coordination.ErrSessionClosed)
+}
+
+func TestSessionResendAfterReconnect(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := newFakeCoordinationService()
+	service.dropAcquire = true
+
+	s, err := newTestClient(service).Session(ctx, "/local/node",
+		options.WithSessionReconnectDelay(time.Millisecond),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.CreateSemaphore(ctx, "lock", 1))
+
+	lease, err := s.AcquireSemaphore(ctx, "lock", 1)
+	require.NoError(t, err)
+	require.NoError(t, lease.Context().Err())
+
+	service.mu.Lock()
+	streams := len(service.streams)
+	service.mu.Unlock()
+	require.Equal(t, 2, streams)
+
+	require.NoError(t, s.Close(ctx))
+}
+
+func TestSessionAcquireCanceled(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := newFakeCoordinationService()
+
+	s, err := newTestClient(service).Session(ctx, "/local/node")
+	require.NoError(t, err)
+	require.NoError(t, s.CreateSemaphore(ctx, "lock", 1))
+
+	_, err = s.AcquireSemaphore(ctx, "lock", 1)
+	require.NoError(t, err)
+
+	childCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = s.AcquireSemaphore(childCtx, "lock", 1)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	require.NoError(t, s.Close(ctx))
+}
@@ -0,0 +1,168 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Export_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/export"
+	"github.com/ydb-platform/ydb-go-sdk/v3/export/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/export/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// fakeExportService keeps export operations in memory instead of uploading data to S3
+type fakeExportService struct {
+	Ydb_Export_V1.ExportServiceClient
+	Ydb_Operation_V1.OperationServiceClient
+
+	request    *Ydb_Export.ExportToS3Request
+	operations map[string]*Ydb_Operations.Operation
+}
+
+func (f *fakeExportService) ExportToS3(
+	_ context.Context, request *Ydb_Export.ExportToS3Request, _ ...grpc.CallOption,
+) (*Ydb_Export.ExportToS3Response, error) {
+	f.request = request
+	metadata, err := anypb.New(&Ydb_Export.ExportToS3Metadata{
+		Settings: request.GetSettings(),
+		Progress: Ydb_Export.ExportProgress_PROGRESS_PREPARING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	op := &Ydb_Operations.Operation{
+		Id:       "export-1",
+		Metadata: metadata,
+	}
+	f.operations[op.GetId()] = op
+	return &Ydb_Export.ExportToS3Response{Operation: op}, nil
+}
+
+func (f *fakeExportService) GetOperation(
+	_ context.Context, request *Ydb_Operations.GetOperationRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	op, has := f.operations[request.GetId()]
+	if !has {
+		return &Ydb_Operations.GetOperationResponse{
+			Operation: &Ydb_Operations.Operation{
+				Id:     request.GetId(),
+				Ready:  true,
+				Status: Ydb.StatusIds_NOT_FOUND,
+			},
+		}, nil
+	}
+	return &Ydb_Operations.GetOperationResponse{Operation: op}, nil
+}
+
+// Invoke makes fakeExportService a connection for operation client
+func (f *fakeExportService) Invoke(
+	ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption,
+) error {
+	if method != Ydb_Operation_V1.OperationService_GetOperation_FullMethodName {
+		return fmt.Errorf("unexpected method %q", method)
+	}
+	response, err := f.GetOperation(ctx, args.(*Ydb_Operations.GetOperationRequest), opts...)
+	if err != nil {
+		return err
+	}
+	proto.Merge(reply.(proto.Message), response)
+	return nil
+}
+
+func (f *fakeExportService) NewStream(
+	context.Context, *grpc.StreamDesc, string, ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return nil, errors.New("streams are not supported")
+}
+
+func (f *fakeExportService) finish(id string) error {
+	metadata, err := anypb.New(&Ydb_Export.ExportToS3Metadata{
+		Progress: Ydb_Export.ExportProgress_PROGRESS_DONE,
+		ItemsProgress: []*Ydb_Export.ExportItemProgress{
+			{PartsTotal: 2, PartsCompleted: 2},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	f.operations[id] = &Ydb_Operations.Operation{
+		Id:       id,
+		Ready:    true,
+		Status:   Ydb.StatusIds_SUCCESS,
+		Metadata: metadata,
+	}
+	return nil
+}
+
+func TestExportToS3(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &fakeExportService{
+		operations: make(map[string]*Ydb_Operations.Operation),
+	}
+	c := &Client{
+		config:     config.New(),
+		service:    service,
+		operations: operation.New(service, operationConfig.New()),
+	}
+
+	op, err := c.ExportToS3(ctx,
+		export.S3{
+			Endpoint:  "localhost:9000",
+			Bucket:    "backups",
+			AccessKey: "access",
+			SecretKey: "secret",
+		},
+		[]export.Item{
+			{SourcePath: "/local/series", DestinationPrefix: "daily/series"},
+		},
+		options.WithCompression("zstd-3"),
+		options.WithNumberOfRetries(5),
+		options.WithStorageClass("STANDARD_IA"),
+		options.WithHTTP(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, &export.Operation{
+		ID:            "export-1",
+		Progress:      export.ProgressPreparing,
+		ItemsProgress: []export.ItemProgress{},
+	}, op)
+
+	settings := service.request.GetSettings()
+	require.Equal(t, Ydb_Operations.OperationParams_ASYNC, service.request.GetOperationParams().GetOperationMode())
+	require.Equal(t, "localhost:9000", settings.GetEndpoint())
+	require.Equal(t, "backups", settings.GetBucket())
+	require.Equal(t, "access", settings.GetAccessKey())
+	require.Equal(t, "secret", settings.GetSecretKey())
+	require.Equal(t, "zstd-3", settings.GetCompression())
+	require.Equal(t, uint32(5), settings.GetNumberOfRetries())
+	require.Equal(t, Ydb_Export.ExportToS3Settings_STANDARD_IA, settings.GetStorageClass())
+	require.Equal(t, Ydb_Export.ExportToS3Settings_HTTP, settings.GetScheme())
+	require.Len(t, settings.GetItems(), 1)
+	require.Equal(t, "/local/series", settings.GetItems()[0].GetSourcePath())
+	require.Equal(t, "daily/series", settings.GetItems()[0].GetDestinationPrefix())
+
+	require.NoError(t, service.finish(op.ID))
+	op, err = c.GetOperation(ctx, op.ID)
+	require.NoError(t, err)
+	require.True(t, op.Ready)
+	require.Equal(t, export.ProgressDone, op.Progress)
+	require.Equal(t, []export.ItemProgress{{PartsTotal: 2, PartsCompleted: 2}}, op.ItemsProgress)
+
+	_, err = c.GetOperation(ctx, "unknown")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+}
@@ -20,6 +20,9 @@ const (
 	CodecCustomerEnd   = 20000 // last allowed custom codec id is 19999
 )
 
+// CodecLz4 is custom codec id of lz4 frame format, supported by sdk by default
+const CodecLz4 = Codec(CodecCustomerEnd - 1)
+
 func (c Codec) IsCustomerCodec() bool {
 	return c >= CodecCustomerFirst && c <= CodecCustomerEnd
 }
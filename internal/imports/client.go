@@ -0,0 +1,153 @@
+package imports
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Import_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/imports"
+	"github.com/ydb-platform/ydb-go-sdk/v3/imports/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/imports/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+//nolint:gofumpt
+//nolint:nolintlint
+var (
+	errNilClient = xerrors.Wrap(errors.New("import client is not initialized"))
+)
+
+type Client struct {
+	config     config.Config
+	service    Ydb_Import_V1.ImportServiceClient
+	operations *operation.Client
+}
+
+func New(cc grpc.ClientConnInterface, config config.Config) *Client {
+	return &Client{
+		config:  config,
+		service: Ydb_Import_V1.NewImportServiceClient(cc),
+		operations: operation.New(cc, operationConfig.New(
+			operationConfig.With(config.Common),
+		)),
+	}
+}
+
+func (c *Client) ImportFromS3(
+	ctx context.Context,
+	s3 imports.S3,
+	items []imports.Item,
+	opts ...options.ImportFromS3Option,
+) (op *imports.Operation, err error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	call := func(ctx context.Context) (err error) {
+		op, err = c.importFromS3(ctx, s3, items, opts...)
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		return op, call(ctx)
+	}
+	return op, retry.Retry(ctx, call, retry.WithStackTrace())
+}
+
+func (c *Client) importFromS3(
+	ctx context.Context,
+	s3 imports.S3,
+	items []imports.Item,
+	opts ...options.ImportFromS3Option,
+) (*imports.Operation, error) {
+	settings := &Ydb_Import.ImportFromS3Settings{
+		Endpoint:  s3.Endpoint,
+		Bucket:    s3.Bucket,
+		AccessKey: s3.AccessKey,
+		SecretKey: s3.SecretKey,
+		Items:     make([]*Ydb_Import.ImportFromS3Settings_Item, 0, len(items)),
+	}
+	for _, item := range items {
+		settings.Items = append(settings.Items, &Ydb_Import.ImportFromS3Settings_Item{
+			SourcePrefix:    item.SourcePrefix,
+			DestinationPath: item.DestinationPath,
+		})
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(settings)
+		}
+	}
+	response, err := c.service.ImportFromS3(
+		conn.WithoutWrapping(ctx),
+		&Ydb_Import.ImportFromS3Request{
+			OperationParams: operation.Params(
+				ctx,
+				c.config.OperationTimeout(),
+				c.config.OperationCancelAfter(),
+				operation.ModeAsync,
+			),
+			Settings: settings,
+		},
+	)
+	if err = operation.Error(response.GetOperation(), err); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	op := response.GetOperation()
+	return operationFromYDB(op.GetId(), op.GetReady(), op.GetMetadata())
+}
+
+// GetOperation returns the actual state of the operation with id with polling by operation client
+func (c *Client) GetOperation(ctx context.Context, id string) (*imports.Operation, error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	op, err := c.operations.Get(ctx, id)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return operationFromYDB(op.ID, op.Ready, op.Metadata)
+}
+
+func (c *Client) Close(context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+	return nil
+}
+
+func operationFromYDB(id string, ready bool, anyMetadata *anypb.Any) (*imports.Operation, error) {
+	result := &imports.Operation{
+		ID:    id,
+		Ready: ready,
+	}
+	if anyMetadata == nil {
+		return result, nil
+	}
+	var metadata Ydb_Import.ImportFromS3Metadata
+	if err := anyMetadata.UnmarshalTo(&metadata); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	result.Progress = imports.Progress(metadata.GetProgress())
+	result.ItemsProgress = make([]imports.ItemProgress, 0, len(metadata.GetItemsProgress()))
+	for _, item := range metadata.GetItemsProgress() {
+		progress := imports.ItemProgress{
+			PartsTotal:     item.GetPartsTotal(),
+			PartsCompleted: item.GetPartsCompleted(),
+		}
+		if item.GetStartTime() != nil {
+			progress.StartTime = item.GetStartTime().AsTime()
+		}
+		if item.GetEndTime() != nil {
+			progress.EndTime = item.GetEndTime().AsTime()
+		}
+		result.ItemsProgress = append(result.ItemsProgress, progress)
+	}
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package imports
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Import_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/imports"
+	"github.com/ydb-platform/ydb-go-sdk/v3/imports/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/imports/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type fakeImportService struct {
+	Ydb_Import_V1.ImportServiceClient
+
+	request *Ydb_Import.ImportFromS3Request
+	status  Ydb.StatusIds_StatusCode
+}
+
+func (f *fakeImportService) ImportFromS3(
+	_ context.Context, request *Ydb_Import.ImportFromS3Request, _ ...grpc.CallOption,
+) (*Ydb_Import.ImportFromS3Response, error) {
+	f.request = request
+	if f.status != Ydb.StatusIds_SUCCESS {
+		return &Ydb_Import.ImportFromS3Response{
+			Operation: &Ydb_Operations.Operation{Ready: true, Status: f.status},
+		}, nil
+	}
+	metadata, err := anypb.New(&Ydb_Import.ImportFromS3Metadata{
+		Progress: Ydb_Import.ImportProgress_PROGRESS_TRANSFER_DATA,
+		ItemsProgress: []*Ydb_Import.ImportItemProgress{
+			{PartsTotal: 3, PartsCompleted: 1},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Ydb_Import.ImportFromS3Response{
+		Operation: &Ydb_Operations.Operation{Id: "import-1", Metadata: metadata},
+	}, nil
+}
+
+func TestImportFromS3(t *testing.T) {
+	ctx := xtest.Context(t)
+	service := &fakeImportService{status: Ydb.StatusIds_SUCCESS}
+	c := &Client{
+		config:  config.New(),
+		service: service,
+	}
+	s3 := imports.S3{Endpoint: "localhost:9000", Bucket: "backups", AccessKey: "access", SecretKey: "secret"}
+	items := []imports.Item{{SourcePrefix: "daily/series", DestinationPath: "/local/restored"}}
+
+	op, err := c.ImportFromS3(ctx, s3, items,
+		options.WithDescription("restore"),
+		options.WithNumberOfRetries(3),
+	)
+	require.NoError(t, err)
+	require.Equal(t, &imports.Operation{
+		ID:            "import-1",
+		Progress:      imports.ProgressTransferData,
+		ItemsProgress: []imports.ItemProgress{{PartsTotal: 3, PartsCompleted: 1}},
+	}, op)
+
+	settings := service.request.GetSettings()
+	require.Equal(t, "restore", settings.GetDescription())
+	require.Equal(t, uint32(3), settings.GetNumberOfRetries())
+	require.Equal(t, "backups", settings.GetBucket())
+	require.Len(t, settings.GetItems(), 1)
+	require.Equal(t, "daily/series", settings.GetItems()[0].GetSourcePrefix())
+	require.Equal(t, "/local/restored", settings.GetItems()[0].GetDestinationPath())
+
+	service.status = Ydb.StatusIds_SCHEME_ERROR
+	_, err = c.ImportFromS3(ctx, s3, items)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR))
+}
@@ -11,6 +11,15 @@ func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, HeaderTraceID, traceID)
 }
 
+// WithTraceParent returns a copy of parent context with traceparent header of W3C trace context.
+// Previous value of traceparent header is replaced because request may belong to the single trace only
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(HeaderTraceParent, traceParent)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
 // WithUserAgent returns a copy of parent context with custom user-agent info
 func WithUserAgent(ctx context.Context, userAgent string) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, HeaderUserAgent, userAgent)
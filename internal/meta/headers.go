@@ -10,6 +10,9 @@ const (
 	HeaderUserAgent          = "x-ydb-user-agent"
 	HeaderClientCapabilities = "x-ydb-client-capabilities"
 
+	// HeaderTraceParent is a header of W3C trace context (https://www.w3.org/TR/trace-context/#traceparent-header)
+	HeaderTraceParent = "traceparent"
+
 	// outgoing hints
 	HintSessionBalancer = "session-balancer"
 
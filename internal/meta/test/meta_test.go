@@ -29,6 +29,10 @@ func TestMetaRequiredHeaders(t *testing.T) {
 
 	ctx = meta.WithTraceID(ctx, "traceID")
 
+	ctx = meta.WithTraceParent(ctx, "00-00000000000000000000000000000001-0000000000000001-01")
+
+	ctx = meta.WithTraceParent(ctx, "00-00000000000000000000000000000001-0000000000000002-01")
+
 	ctx = metadata.AppendToOutgoingContext(ctx, "some-user-header", "some-user-value")
 
 	ctx, err := m.Context(ctx)
@@ -45,6 +49,9 @@ func TestMetaRequiredHeaders(t *testing.T) {
 	require.Equal(t, []string{"token"}, md.Get(internal.HeaderTicket))
 	require.Equal(t, []string{"userAgent", "user-agent"}, md.Get(internal.HeaderUserAgent))
 	require.Equal(t, []string{"traceID"}, md.Get(internal.HeaderTraceID))
+	require.Equal(t, []string{
+		"00-00000000000000000000000000000001-0000000000000002-01",
+	}, md.Get(internal.HeaderTraceParent))
 	require.Equal(t, []string{
 		"ydb-go-sdk/" + version.Major + "." + version.Minor + "." + version.Patch,
 	}, md.Get(internal.HeaderVersion))
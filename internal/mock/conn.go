@@ -11,12 +11,13 @@ import (
 )
 
 type Conn struct {
-	PingErr       error
-	AddrField     string
-	LocationField string
-	NodeIDField   uint32
-	State         conn.State
-	LocalDCField  bool
+	PingErr         error
+	AddrField       string
+	LocationField   string
+	NodeIDField     uint32
+	State           conn.State
+	LocalDCField    bool
+	LoadFactorField float32
 }
 
 func (c *Conn) Invoke(
@@ -38,10 +39,11 @@ func (c *Conn) NewStream(ctx context.Context,
 
 func (c *Conn) Endpoint() endpoint.Endpoint {
 	return &Endpoint{
-		AddrField:     c.AddrField,
-		LocalDCField:  c.LocalDCField,
-		LocationField: c.LocationField,
-		NodeIDField:   c.NodeIDField,
+		AddrField:       c.AddrField,
+		LocalDCField:    c.LocalDCField,
+		LocationField:   c.LocationField,
+		NodeIDField:     c.NodeIDField,
+		LoadFactorField: c.LoadFactorField,
 	}
 }
 
@@ -76,10 +78,11 @@ func (c *Conn) Unban() conn.State {
 }
 
 type Endpoint struct {
-	AddrField     string
-	LocationField string
-	NodeIDField   uint32
-	LocalDCField  bool
+	AddrField       string
+	LocationField   string
+	NodeIDField     uint32
+	LocalDCField    bool
+	LoadFactorField float32
 }
 
 func (e *Endpoint) Choose(bool) {
@@ -106,7 +109,7 @@ func (e *Endpoint) LastUpdated() time.Time {
 }
 
 func (e *Endpoint) LoadFactor() float32 {
-	panic("not implemented in mock")
+	return e.LoadFactorField
 }
 
 func (e *Endpoint) String() string {
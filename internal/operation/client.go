@@ -0,0 +1,190 @@
+package operation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+//nolint:gofumpt
+//nolint:nolintlint
+var (
+	errNilClient = xerrors.Wrap(errors.New("operation client is not initialized"))
+)
+
+type Client struct {
+	config  config.Config
+	service Ydb_Operation_V1.OperationServiceClient
+}
+
+func New(cc grpc.ClientConnInterface, config config.Config) *Client {
+	return &Client{
+		config:  config,
+		service: Ydb_Operation_V1.NewOperationServiceClient(cc),
+	}
+}
+
+func (c *Client) Get(ctx context.Context, id string) (op *operation.Operation, err error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	call := func(ctx context.Context) (err error) {
+		op, err = c.get(ctx, id)
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		return op, call(ctx)
+	}
+	return op, retry.Retry(ctx, call, retry.WithStackTrace(), retry.WithIdempotent(true))
+}
+
+func (c *Client) get(ctx context.Context, id string) (*operation.Operation, error) {
+	response, err := c.service.GetOperation(
+		conn.WithoutWrapping(ctx),
+		&Ydb_Operations.GetOperationRequest{
+			Id: id,
+		},
+	)
+	if err = Error(response.GetOperation(), err); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return operationFromYDB(response.GetOperation()), nil
+}
+
+func (c *Client) Cancel(ctx context.Context, id string) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+	call := func(ctx context.Context) error {
+		return xerrors.WithStackTrace(c.cancel(ctx, id))
+	}
+	if !c.config.AutoRetry() {
+		return call(ctx)
+	}
+	return retry.Retry(ctx, call, retry.WithStackTrace(), retry.WithIdempotent(true))
+}
+
+func (c *Client) cancel(ctx context.Context, id string) error {
+	response, err := c.service.CancelOperation(
+		conn.WithoutWrapping(ctx),
+		&Ydb_Operations.CancelOperationRequest{
+			Id: id,
+		},
+	)
+	if err = statusError(response, err); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return nil
+}
+
+func (c *Client) Forget(ctx context.Context, id string) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+	call := func(ctx context.Context) error {
+		return xerrors.WithStackTrace(c.forget(ctx, id))
+	}
+	if !c.config.AutoRetry() {
+		return call(ctx)
+	}
+	return retry.Retry(ctx, call, retry.WithStackTrace(), retry.WithIdempotent(true))
+}
+
+func (c *Client) forget(ctx context.Context, id string) error {
+	response, err := c.service.ForgetOperation(
+		conn.WithoutWrapping(ctx),
+		&Ydb_Operations.ForgetOperationRequest{
+			Id: id,
+		},
+	)
+	if err = statusError(response, err); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return nil
+}
+
+func (c *Client) List(ctx context.Context, kind string, opts ...options.ListOption) (
+	operations []*operation.Operation, nextPageToken string, err error,
+) {
+	if c == nil {
+		return nil, "", xerrors.WithStackTrace(errNilClient)
+	}
+	call := func(ctx context.Context) (err error) {
+		operations, nextPageToken, err = c.list(ctx, kind, opts...)
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		err = call(ctx)
+		return operations, nextPageToken, err
+	}
+	err = retry.Retry(ctx, call, retry.WithStackTrace(), retry.WithIdempotent(true))
+	return operations, nextPageToken, err
+}
+
+func (c *Client) list(ctx context.Context, kind string, opts ...options.ListOption) (
+	[]*operation.Operation, string, error,
+) {
+	request := &Ydb_Operations.ListOperationsRequest{
+		Kind: kind,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(request)
+		}
+	}
+	response, err := c.service.ListOperations(conn.WithoutWrapping(ctx), request)
+	if err = statusError(response, err); err != nil {
+		return nil, "", xerrors.WithStackTrace(err)
+	}
+	operations := make([]*operation.Operation, 0, len(response.GetOperations()))
+	for _, op := range response.GetOperations() {
+		operations = append(operations, operationFromYDB(op))
+	}
+	return operations, response.GetNextPageToken(), nil
+}
+
+func (c *Client) Wait(ctx context.Context, id string, opts ...options.WaitOption) (*operation.Operation, error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	waitOptions := options.NewWaitOptions(opts...)
+	for i := 0; ; i++ {
+		op, err := c.Get(ctx, id)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if op.Ready {
+			return op, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, xerrors.WithStackTrace(ctx.Err())
+		case <-waitOptions.Backoff.Wait(i):
+		}
+	}
+}
+
+func (c *Client) Close(context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+	return nil
+}
+
+func operationFromYDB(op *Ydb_Operations.Operation) *operation.Operation {
+	return &operation.Operation{
+		ID:       op.GetId(),
+		Ready:    op.GetReady(),
+		Metadata: op.GetMetadata(),
+	}
+}
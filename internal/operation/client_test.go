@@ -0,0 +1,184 @@
+package operation
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+// fakeOperationService keeps operations in memory.
+// Each GetOperation call makes the operation one step closer to be ready.
+type fakeOperationService struct {
+	Ydb_Operation_V1.OperationServiceClient
+
+	mu         sync.Mutex
+	operations []*Ydb_Operations.Operation
+	// stepsLeft is a number of GetOperation calls before operation become ready
+	stepsLeft map[string]int
+}
+
+func (f *fakeOperationService) find(id string) *Ydb_Operations.Operation {
+	for _, op := range f.operations {
+		if op.GetId() == id {
+			return op
+		}
+	}
+	return nil
+}
+
+func (f *fakeOperationService) GetOperation(
+	_ context.Context, request *Ydb_Operations.GetOperationRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	op := f.find(request.GetId())
+	if op == nil {
+		return &Ydb_Operations.GetOperationResponse{
+			Operation: &Ydb_Operations.Operation{Ready: true, Status: Ydb.StatusIds_NOT_FOUND},
+		}, nil
+	}
+	if f.stepsLeft[op.GetId()]--; f.stepsLeft[op.GetId()] <= 0 {
+		op.Ready = true
+	}
+	return &Ydb_Operations.GetOperationResponse{Operation: op}, nil
+}
+
+func (f *fakeOperationService) CancelOperation(
+	_ context.Context, request *Ydb_Operations.CancelOperationRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.CancelOperationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	op := f.find(request.GetId())
+	if op == nil {
+		return &Ydb_Operations.CancelOperationResponse{Status: Ydb.StatusIds_NOT_FOUND}, nil
+	}
+	op.Ready = true
+	op.Status = Ydb.StatusIds_CANCELLED
+	return &Ydb_Operations.CancelOperationResponse{Status: Ydb.StatusIds_SUCCESS}, nil
+}
+
+func (f *fakeOperationService) ForgetOperation(
+	_ context.Context, request *Ydb_Operations.ForgetOperationRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.ForgetOperationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, op := range f.operations {
+		if op.GetId() == request.GetId() {
+			f.operations = append(f.operations[:i], f.operations[i+1:]...)
+			return &Ydb_Operations.ForgetOperationResponse{Status: Ydb.StatusIds_SUCCESS}, nil
+		}
+	}
+	return &Ydb_Operations.ForgetOperationResponse{Status: Ydb.StatusIds_NOT_FOUND}, nil
+}
+
+func (f *fakeOperationService) ListOperations(
+	_ context.Context, request *Ydb_Operations.ListOperationsRequest, _ ...grpc.CallOption,
+) (*Ydb_Operations.ListOperationsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if request.GetKind() == "" {
+		return &Ydb_Operations.ListOperationsResponse{Status: Ydb.StatusIds_BAD_REQUEST}, nil
+	}
+	response := &Ydb_Operations.ListOperationsResponse{Status: Ydb.StatusIds_SUCCESS}
+	for i, op := range f.operations {
+		if request.GetPageToken() != "" && op.GetId() < request.GetPageToken() {
+			continue
+		}
+		if uint64(len(response.Operations)) == request.GetPageSize() {
+			response.NextPageToken = f.operations[i].GetId()
+			break
+		}
+		response.Operations = append(response.Operations, op)
+	}
+	return response, nil
+}
+
+func newTestClient(operations ...*Ydb_Operations.Operation) (*Client, *fakeOperationService) {
+	service := &fakeOperationService{
+		operations: operations,
+		stepsLeft:  make(map[string]int),
+	}
+	return &Client{
+		config:  config.New(),
+		service: service,
+	}, service
+}
+
+func TestClientWait(t *testing.T) {
+	ctx := xtest.Context(t)
+	c, service := newTestClient(
+		&Ydb_Operations.Operation{Id: "1", Status: Ydb.StatusIds_SUCCESS},
+	)
+	service.stepsLeft["1"] = 3
+
+	op, err := c.Get(ctx, "1")
+	require.NoError(t, err)
+	require.False(t, op.Ready)
+
+	op, err = c.Wait(ctx, "1", options.WithBackoff(retry.Backoff(time.Millisecond, 1, 1)))
+	require.NoError(t, err)
+	require.True(t, op.Ready)
+	require.Equal(t, "1", op.ID)
+
+	_, err = c.Get(ctx, "2")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+}
+
+func TestClientCancelAndForget(t *testing.T) {
+	ctx := xtest.Context(t)
+	c, service := newTestClient(
+		&Ydb_Operations.Operation{Id: "1", Status: Ydb.StatusIds_SUCCESS},
+	)
+	service.stepsLeft["1"] = 100
+
+	require.NoError(t, c.Cancel(ctx, "1"))
+	_, err := c.Wait(ctx, "1")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_CANCELLED))
+
+	require.NoError(t, c.Forget(ctx, "1"))
+	err = c.Forget(ctx, "1")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+}
+
+func TestClientList(t *testing.T) {
+	ctx := xtest.Context(t)
+	c, _ := newTestClient(
+		&Ydb_Operations.Operation{Id: "1"},
+		&Ydb_Operations.Operation{Id: "2"},
+		&Ydb_Operations.Operation{Id: "3"},
+	)
+
+	operations, nextPageToken, err := c.List(ctx, "export", options.WithPageSize(2))
+	require.NoError(t, err)
+	require.Len(t, operations, 2)
+	require.Equal(t, "3", nextPageToken)
+
+	operations, nextPageToken, err = c.List(ctx, "export",
+		options.WithPageSize(2),
+		options.WithPageToken(nextPageToken),
+	)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+	require.Equal(t, "3", operations[0].ID)
+	require.Empty(t, nextPageToken)
+
+	_, _, err = c.List(ctx, "")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+}
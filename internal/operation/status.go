@@ -0,0 +1,45 @@
+package operation
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Error checks the result of a call which was made without errors wrapping (conn.WithoutWrapping)
+// and the status of returned operation. Not ready operation is not an error.
+func Error(op *Ydb_Operations.Operation, err error) error {
+	if err != nil {
+		return callError(err)
+	}
+	if op.GetReady() && op.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(op)))
+	}
+	return nil
+}
+
+type responseStatus interface {
+	GetStatus() Ydb.StatusIds_StatusCode
+	GetIssues() []*Ydb_Issue.IssueMessage
+}
+
+// statusError checks the result of a call which was made without errors wrapping (conn.WithoutWrapping)
+// and the status of response without operation
+func statusError(response responseStatus, err error) error {
+	if err != nil {
+		return callError(err)
+	}
+	if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+	}
+	return nil
+}
+
+func callError(err error) error {
+	if xerrors.IsTransportError(err) {
+		return xerrors.WithStackTrace(xerrors.Transport(err))
+	}
+	return xerrors.WithStackTrace(err)
+}
@@ -0,0 +1,40 @@
+package operation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestError(t *testing.T) {
+	require.NoError(t, Error(&Ydb_Operations.Operation{Id: "1"}, nil))
+	require.NoError(t, Error(&Ydb_Operations.Operation{Ready: true, Status: Ydb.StatusIds_SUCCESS}, nil))
+	require.True(t, xerrors.IsOperationError(
+		Error(&Ydb_Operations.Operation{Ready: true, Status: Ydb.StatusIds_BAD_REQUEST}, nil),
+		Ydb.StatusIds_BAD_REQUEST,
+	))
+	require.True(t, xerrors.IsTransportError(
+		Error(nil, grpcStatus.Error(grpcCodes.Unavailable, "")),
+		grpcCodes.Unavailable,
+	))
+	require.ErrorIs(t, Error(nil, context.Canceled), context.Canceled)
+}
+
+func TestStatusError(t *testing.T) {
+	require.NoError(t, statusError(&Ydb_Operations.CancelOperationResponse{Status: Ydb.StatusIds_SUCCESS}, nil))
+	require.True(t, xerrors.IsOperationError(
+		statusError(&Ydb_Operations.CancelOperationResponse{Status: Ydb.StatusIds_NOT_FOUND}, nil),
+		Ydb.StatusIds_NOT_FOUND,
+	))
+	require.True(t, xerrors.IsTransportError(
+		statusError(nil, grpcStatus.Error(grpcCodes.Unavailable, "")),
+		grpcCodes.Unavailable,
+	))
+}
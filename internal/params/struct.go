@@ -0,0 +1,117 @@
+package params
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xreflect"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// Field is a named YDB value of struct field
+type Field struct {
+	Name  string
+	Value types.Value
+}
+
+// Fields converts exported fields of struct (or pointer to struct) to YDB values.
+//
+// Field name defines by "ydb" or "sql" tag or equals to Go field name. Fields with tag "-" are skipped.
+// Fields of embedded structs without tag are promoted
+func Fields(v interface{}) (fields []Field, err error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%T: %w: must be a struct or pointer to struct",
+			v, ErrUnsupportedType,
+		))
+	}
+	return structFields(rv, fields)
+}
+
+func structFields(rv reflect.Value, fields []Field) (_ []Field, err error) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, tagged := xreflect.FieldName(f)
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && !tagged && f.Type.Kind() == reflect.Struct {
+			if fields, err = structFields(rv.Field(i), fields); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !rv.Field(i).CanInterface() { // unexported
+			continue
+		}
+		v, err := ReflectValue(rv.Field(i).Interface())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("field %s.%s: %w", t, f.Name, err))
+		}
+		fields = append(fields, Field{
+			Name:  name,
+			Value: v,
+		})
+	}
+	return fields, nil
+}
+
+// reflectValue converts structs, slices, arrays and pointers to YDB values
+func reflectValue(rv reflect.Value) (_ types.Value, err error) {
+	switch rv.Kind() {
+	case reflect.Struct:
+		fields, err := structFields(rv, nil)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		opts := make([]types.StructValueOption, 0, len(fields))
+		for _, f := range fields {
+			opts = append(opts, types.StructFieldValue(f.Name, f.Value))
+		}
+		return types.StructValue(opts...), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Len() == 0 {
+			t, err := reflectType(rv.Type().Elem())
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			return types.ZeroValue(types.List(t)), nil
+		}
+		items := make([]types.Value, rv.Len())
+		for i := range items {
+			if items[i], err = ReflectValue(rv.Index(i).Interface()); err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+		return types.ListValue(items...), nil
+	case reflect.Ptr:
+		if rv.IsNil() {
+			t, err := reflectType(rv.Type().Elem())
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			return types.NullValue(t), nil
+		}
+		v, err := ReflectValue(rv.Elem().Interface())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		return types.OptionalValue(v), nil
+	default:
+		return nil, unsupportedTypeError(rv.Interface())
+	}
+}
+
+// reflectType returns YDB type of zero value of Go type
+func reflectType(t reflect.Type) (types.Type, error) {
+	v, err := ReflectValue(reflect.Zero(t).Interface())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return v.Type(), nil
+}
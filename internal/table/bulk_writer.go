@@ -0,0 +1,352 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	defaultBulkWriterMaxBatchRows  = 1000
+	defaultBulkWriterMaxBatchBytes = 8 * 1024 * 1024
+	defaultBulkWriterFlushInterval = time.Second
+	defaultBulkWriterConcurrency   = 4
+)
+
+var _ table.BulkWriter = (*bulkWriter)(nil)
+
+// bulkWriter groups rows into batches and uploads batches with concurrent BulkUpsert calls
+type bulkWriter struct {
+	c        *Client
+	path     string
+	settings options.BulkWriterSettings
+
+	// keyColumns and bounds are defined if rows are sharded by table partitions.
+	// bounds[i] is an exclusive upper bound of keys of i-th partition
+	keyColumns []string
+	bounds     []types.Value
+
+	// ctx is a context of BulkUpsert calls, it lives until bulkWriter closes
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	batches []*bulkWriterBatch // batch per shard
+	err     error              // first error of BulkUpsert calls
+	closed  bool
+
+	// inflight limits count of concurrent BulkUpsert calls
+	inflight chan struct{}
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+type bulkWriterBatch struct {
+	shard int
+	rows  []types.Value
+	bytes int
+}
+
+// BulkWriter returns writer which uploads rows to the table at path with BulkUpsert calls
+func (c *Client) BulkWriter(
+	ctx context.Context, path string, opts ...options.BulkWriterOption,
+) (_ table.BulkWriter, err error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	if c.isClosed() {
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	}
+
+	w := &bulkWriter{
+		c:    c,
+		path: path,
+		settings: options.BulkWriterSettings{
+			MaxBatchRows:  defaultBulkWriterMaxBatchRows,
+			MaxBatchBytes: defaultBulkWriterMaxBatchBytes,
+			FlushInterval: defaultBulkWriterFlushInterval,
+			Concurrency:   defaultBulkWriterConcurrency,
+		},
+		done: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyBulkWriterOption(&w.settings)
+		}
+	}
+	if w.settings.MaxBatchRows <= 0 {
+		w.settings.MaxBatchRows = defaultBulkWriterMaxBatchRows
+	}
+	if w.settings.Concurrency <= 0 {
+		w.settings.Concurrency = 1
+	}
+
+	if w.settings.ShardByKeyBounds {
+		var desc options.Description
+		err = c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+			desc, err = s.DescribeTable(ctx, path, options.WithShardKeyBounds())
+			return err
+		}, table.WithIdempotent())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		w.keyColumns = desc.PrimaryKey
+		for i := range desc.KeyRanges {
+			if to := desc.KeyRanges[i].To; to != nil {
+				w.bounds = append(w.bounds, to)
+			}
+		}
+	}
+
+	w.batches = make([]*bulkWriterBatch, len(w.bounds)+1)
+	for i := range w.batches {
+		w.batches[i] = &bulkWriterBatch{shard: i}
+	}
+	w.inflight = make(chan struct{}, w.settings.Concurrency)
+	w.ctx, w.cancel = xcontext.WithCancel(xcontext.WithoutDeadline(ctx))
+
+	if w.settings.FlushInterval > 0 {
+		w.wg.Add(1)
+		go w.flushLoop()
+	}
+
+	return w, nil
+}
+
+// Write appends rows to batches and uploads full batches.
+// Write blocks while max count of BulkUpsert calls are in flight.
+// After first failed BulkUpsert call Write returns error of failed call
+func (w *bulkWriter) Write(ctx context.Context, rows ...types.Value) error {
+	a := allocator.New()
+	defer a.Free()
+
+	for _, row := range rows {
+		shard, err := w.shard(row)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		size := proto.Size(value.ToYDB(row, a))
+
+		var full *bulkWriterBatch
+		err = func() error {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			if w.closed {
+				return xerrors.WithStackTrace(errClosedBulkWriter)
+			}
+			if w.err != nil {
+				return xerrors.WithStackTrace(w.err)
+			}
+
+			batch := w.batches[shard]
+			batch.rows = append(batch.rows, row)
+			batch.bytes += size
+			if len(batch.rows) >= w.settings.MaxBatchRows ||
+				(w.settings.MaxBatchBytes > 0 && batch.bytes >= w.settings.MaxBatchBytes) {
+				full = batch
+				w.batches[shard] = &bulkWriterBatch{shard: shard}
+			}
+			return nil
+		}()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		if full != nil {
+			if err = w.upsert(ctx, full); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Flush uploads all written rows and waits for done of all BulkUpsert calls
+func (w *bulkWriter) Flush(ctx context.Context) error {
+	batches := w.detach()
+	for i, batch := range batches {
+		if err := w.upsert(ctx, batch); err != nil {
+			w.restore(batches[i+1:]...)
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	// all BulkUpsert calls are done if all inflight slots are acquired
+	for i := 0; i < cap(w.inflight); i++ {
+		select {
+		case <-ctx.Done():
+			for ; i > 0; i-- {
+				<-w.inflight
+			}
+			return xerrors.WithStackTrace(ctx.Err())
+		case w.inflight <- struct{}{}:
+		}
+	}
+	for i := 0; i < cap(w.inflight); i++ {
+		<-w.inflight
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return xerrors.WithStackTrace(w.err)
+	}
+
+	return nil
+}
+
+// Close flushes written rows and stops bulkWriter
+func (w *bulkWriter) Close(ctx context.Context) (err error) {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return xerrors.WithStackTrace(errClosedBulkWriter)
+	}
+	w.closed = true
+	w.mu.Unlock()
+
+	defer w.cancel()
+
+	close(w.done)
+	w.wg.Wait()
+
+	if err = w.Flush(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (w *bulkWriter) flushLoop() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.settings.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			for _, batch := range w.detach() {
+				_ = w.upsert(w.ctx, batch)
+			}
+		}
+	}
+}
+
+// detach returns non-empty batches and replaces them with empty ones
+func (w *bulkWriter) detach() (batches []*bulkWriterBatch) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for i, batch := range w.batches {
+		if len(batch.rows) > 0 {
+			batches = append(batches, batch)
+			w.batches[i] = &bulkWriterBatch{shard: i}
+		}
+	}
+
+	return batches
+}
+
+// restore returns not uploaded batches back for upload with next rows or flush
+func (w *bulkWriter) restore(batches ...*bulkWriterBatch) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, batch := range batches {
+		current := w.batches[batch.shard]
+		batch.rows = append(batch.rows, current.rows...)
+		batch.bytes += current.bytes
+		w.batches[batch.shard] = batch
+	}
+}
+
+// upsert waits for free inflight slot and uploads batch in background.
+// Batch is restored if ctx is done before upload
+func (w *bulkWriter) upsert(ctx context.Context, batch *bulkWriterBatch) error {
+	select {
+	case <-ctx.Done():
+		w.restore(batch)
+		return xerrors.WithStackTrace(ctx.Err())
+	case w.inflight <- struct{}{}:
+	}
+
+	go func() {
+		defer func() {
+			<-w.inflight
+		}()
+
+		ctx := w.ctx
+		onDone := trace.TableOnBulkWriterFlush(w.c.config.Trace(), &ctx, w.path, len(batch.rows), batch.bytes)
+
+		err := w.c.Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.BulkUpsert(ctx, w.path, types.ListValue(batch.rows...))
+		}, table.WithIdempotent())
+
+		onDone(err)
+
+		if err != nil {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			if w.err == nil {
+				w.err = err
+			}
+		}
+	}()
+
+	return nil
+}
+
+// shard returns index of table partition for row
+func (w *bulkWriter) shard(row types.Value) (int, error) {
+	if len(w.bounds) == 0 {
+		return 0, nil
+	}
+
+	fields, err := types.StructFields(row)
+	if err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+	key := make([]types.Value, 0, len(w.keyColumns))
+	for _, column := range w.keyColumns {
+		v, has := fields[column]
+		if !has {
+			return 0, xerrors.WithStackTrace(fmt.Errorf("row has no primary key column '%s'", column))
+		}
+		key = append(key, v)
+	}
+	tuple := types.TupleValue(key...)
+
+	var cmpErr error
+	shard := sort.Search(len(w.bounds), func(i int) bool {
+		cmp, err := value.Compare(tuple, w.bounds[i])
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return cmp < 0
+	})
+	if cmpErr != nil {
+		return 0, xerrors.WithStackTrace(cmpErr)
+	}
+
+	return shard, nil
+}
@@ -0,0 +1,196 @@
+package table
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+func TestBulkWriterShard(t *testing.T) {
+	w := &bulkWriter{
+		keyColumns: []string{"a", "b"},
+		bounds: []types.Value{
+			types.TupleValue(types.OptionalValue(types.Uint64Value(10)), types.OptionalValue(types.TextValue("m"))),
+			types.TupleValue(types.OptionalValue(types.Uint64Value(20))),
+		},
+	}
+	row := func(a uint64, b string) types.Value {
+		return types.StructValue(
+			types.StructFieldValue("a", types.Uint64Value(a)),
+			types.StructFieldValue("b", types.TextValue(b)),
+			types.StructFieldValue("c", types.BoolValue(true)),
+		)
+	}
+	for _, tt := range []struct {
+		row   types.Value
+		shard int
+	}{
+		{row(1, "z"), 0},
+		{row(10, "a"), 0},
+		{row(10, "m"), 1},
+		{row(19, "z"), 1},
+		{row(20, "a"), 2},
+		{row(100, ""), 2},
+	} {
+		shard, err := w.shard(tt.row)
+		require.NoError(t, err)
+		require.Equal(t, tt.shard, shard, tt.row.Yql())
+	}
+
+	_, err := w.shard(types.StructValue(types.StructFieldValue("a", types.Uint64Value(1))))
+	require.Error(t, err)
+}
+
+// bulkUpsertCluster counts rows of BulkUpsert calls
+type bulkUpsertCluster struct {
+	mu      sync.Mutex
+	batches []int
+	err     error
+	wait    chan struct{} // BulkUpsert calls wait for close of wait if it is not nil
+}
+
+func (c *bulkUpsertCluster) rows() (batches []int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append(batches, c.batches...)
+}
+
+func newBulkWriterTestClient(t *testing.T, cluster *bulkUpsertCluster) *Client {
+	c := newClientWithStubBuilder(t, testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+		testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+			return &Ydb_Table.CreateSessionResult{
+				SessionId: testutil.SessionID(),
+			}, nil
+		},
+		testutil.TableDeleteSession: okHandler,
+		testutil.TableBulkUpsert: func(request interface{}) (proto.Message, error) {
+			if cluster.wait != nil {
+				<-cluster.wait
+			}
+			rows := request.(*Ydb_Table.BulkUpsertRequest).GetRows().GetValue().GetItems()
+
+			cluster.mu.Lock()
+			defer cluster.mu.Unlock()
+
+			if cluster.err != nil {
+				return nil, cluster.err
+			}
+			cluster.batches = append(cluster.batches, len(rows))
+
+			return &Ydb_Table.BulkUpsertResult{}, nil
+		},
+	})), 0)
+	t.Cleanup(func() {
+		_ = c.Close(context.Background())
+	})
+
+	return c
+}
+
+func newBulkWriter(t *testing.T, c *Client, opts ...options.BulkWriterOption) table.BulkWriter {
+	w, err := c.BulkWriter(xtest.Context(t), "/local/test",
+		append([]options.BulkWriterOption{options.WithBulkWriterFlushInterval(0)}, opts...)...,
+	)
+	require.NoError(t, err)
+
+	return w
+}
+
+func bulkWriterTestRows(n int) (rows []types.Value) {
+	for i := 0; i < n; i++ {
+		rows = append(rows, types.StructValue(types.StructFieldValue("id", types.Uint64Value(uint64(i)))))
+	}
+
+	return rows
+}
+
+func TestBulkWriter(t *testing.T) {
+	t.Run("BatchByRows", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		cluster := &bulkUpsertCluster{}
+		w := newBulkWriter(t, newBulkWriterTestClient(t, cluster),
+			options.WithBulkWriterMaxBatchRows(2),
+			options.WithBulkWriterConcurrency(1),
+		)
+		require.NoError(t, w.Write(ctx, bulkWriterTestRows(5)...))
+		require.NoError(t, w.Flush(ctx))
+		require.Equal(t, []int{2, 2, 1}, cluster.rows())
+	})
+	t.Run("BatchByBytes", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		a := allocator.New()
+		defer a.Free()
+		size := proto.Size(value.ToYDB(bulkWriterTestRows(1)[0], a))
+		cluster := &bulkUpsertCluster{}
+		w := newBulkWriter(t, newBulkWriterTestClient(t, cluster),
+			options.WithBulkWriterMaxBatchBytes(3*size),
+			options.WithBulkWriterConcurrency(1),
+		)
+		require.NoError(t, w.Write(ctx, bulkWriterTestRows(7)...))
+		require.NoError(t, w.Flush(ctx))
+		require.Equal(t, []int{3, 3, 1}, cluster.rows())
+	})
+	t.Run("Error", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		cluster := &bulkUpsertCluster{
+			err: xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR)),
+		}
+		w := newBulkWriter(t, newBulkWriterTestClient(t, cluster),
+			options.WithBulkWriterMaxBatchRows(2),
+		)
+		require.NoError(t, w.Write(ctx, bulkWriterTestRows(2)...))
+		err := w.Flush(ctx)
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR))
+		require.Error(t, w.Write(ctx, bulkWriterTestRows(1)...))
+		require.Error(t, w.Close(ctx))
+		require.Empty(t, cluster.rows())
+	})
+	t.Run("CancelledWrite", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		cluster := &bulkUpsertCluster{
+			wait: make(chan struct{}),
+		}
+		w := newBulkWriter(t, newBulkWriterTestClient(t, cluster),
+			options.WithBulkWriterMaxBatchRows(1),
+			options.WithBulkWriterConcurrency(1),
+		)
+		rows := bulkWriterTestRows(2)
+		require.NoError(t, w.Write(ctx, rows[0]))
+
+		// the only inflight slot is busy, so cancelled Write cannot upload the row and keeps it
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		require.ErrorIs(t, w.Write(cancelledCtx, rows[1]), context.Canceled)
+
+		close(cluster.wait)
+		require.NoError(t, w.Flush(ctx))
+		require.Equal(t, []int{1, 1}, cluster.rows())
+	})
+	t.Run("Close", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		cluster := &bulkUpsertCluster{}
+		w := newBulkWriter(t, newBulkWriterTestClient(t, cluster))
+		require.NoError(t, w.Write(ctx, bulkWriterTestRows(3)...))
+		require.Empty(t, cluster.rows())
+		require.NoError(t, w.Close(ctx))
+		require.Equal(t, []int{3}, cluster.rows())
+		require.ErrorIs(t, w.Write(ctx, bulkWriterTestRows(1)...), errClosedBulkWriter)
+		require.ErrorIs(t, w.Close(ctx), errClosedBulkWriter)
+	})
+}
@@ -10,10 +10,12 @@ import (
 	"github.com/jonboulle/clockwork"
 	"google.golang.org/grpc"
 
+	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
@@ -28,6 +30,18 @@ type nodeChecker interface {
 	HasNode(id uint32) bool
 }
 
+// nodesLister lists nodes of discovered endpoints
+type nodesLister interface {
+	NodeIDs() []uint32
+}
+
+// nodeEndpoint is an endpoint for routing of call to node with balancer.WithEndpoint
+type nodeEndpoint uint32
+
+func (id nodeEndpoint) NodeID() uint32 {
+	return uint32(id)
+}
+
 type balancer interface {
 	grpc.ClientConnInterface
 	nodeChecker
@@ -64,7 +78,12 @@ func newClient(
 				return &ch
 			},
 		},
-		done: make(chan struct{}),
+		done:      make(chan struct{}),
+		latencies: newLatencies(),
+		rand:      xrand.New(xrand.WithLock()),
+	}
+	if nodes, ok := balancer.(nodesLister); ok {
+		c.nodes = nodes
 	}
 	if idleThreshold := config.IdleThreshold(); idleThreshold > 0 {
 		c.wg.Add(1)
@@ -82,12 +101,16 @@ type Client struct {
 	build       sessionBuilder
 	cc          grpc.ClientConnInterface
 	nodeChecker nodeChecker
+	nodes       nodesLister // nil if balancer cannot list nodes
+	rand        xrand.Rand
 	clock       clockwork.Clock
+	latencies   *latencies // latencies of hedgeable operations
 
 	// read-write fields
 	mu                xsync.Mutex
 	index             map[*session]sessionInfo
 	createInProgress  int        // KIKIMR-9163: in-create-process counter
+	createFailures    uint64     // count of failed session creations
 	limit             int        // Upper bound for Client size.
 	idle              *list.List // list<*session>
 	waitQ             *list.List // list<*chan *session>
@@ -255,7 +278,8 @@ func (c *Client) CreateSession(ctx context.Context, opts ...table.Option) (_ tab
 		retry.WithID("CreateSession"),
 		retry.WithFastBackoff(options.FastBackoff),
 		retry.WithSlowBackoff(options.SlowBackoff),
-		retry.WithTrace(trace.Retry{
+		retry.WithBudget(c.config.RetryBudget()),
+		retry.WithTrace(*c.config.RetryTrace().Compose(&trace.Retry{
 			OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
 				onIntermediate := trace.TableOnCreateSession(c.config.Trace(), info.Context)
 				return func(info trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
@@ -265,7 +289,7 @@ func (c *Client) CreateSession(ctx context.Context, opts ...table.Option) (_ tab
 					}
 				}
 			},
-		}),
+		})),
 	)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -340,6 +364,9 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 			})
 		}))
 	if err != nil {
+		c.mu.WithLock(func() {
+			c.createFailures++
+		})
 		return nil, xerrors.WithStackTrace(err)
 	}
 
@@ -455,6 +482,60 @@ func (c *Client) Get(ctx context.Context) (s *session, err error) {
 	return c.internalPoolGet(ctx)
 }
 
+// getOnOtherNode returns idle session on node other than nodeID or creates new session on other node
+// with routing by balancer.WithEndpoint. Returns errNoOtherNode if there is no other node
+func (c *Client) getOnOtherNode(ctx context.Context, nodeID uint32) (s *session, err error) {
+	c.mu.WithLock(func() {
+		for el := c.idle.Front(); el != nil; el = el.Next() {
+			candidate := el.Value.(*session)
+			if candidate.NodeID() == nodeID {
+				continue
+			}
+			if c.nodeChecker != nil && !c.nodeChecker.HasNode(candidate.NodeID()) {
+				continue
+			}
+			c.index[candidate] = c.internalPoolRemoveIdle(candidate)
+			s = candidate
+			return
+		}
+	})
+	if s != nil {
+		return s, nil
+	}
+
+	if c.nodes == nil {
+		return nil, xerrors.WithStackTrace(errNoOtherNode)
+	}
+
+	var others []uint32
+	for _, id := range c.nodes.NodeIDs() {
+		if id != nodeID {
+			others = append(others, id)
+		}
+	}
+	if len(others) == 0 {
+		return nil, xerrors.WithStackTrace(errNoOtherNode)
+	}
+
+	s, err = c.internalPoolCreateSession(
+		balancerContext.WithEndpoint(ctx, nodeEndpoint(others[c.rand.Int(len(others))])),
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if s.NodeID() == nodeID {
+		// balancer routed call to the same node
+		_ = c.Put(ctx, s)
+		return nil, xerrors.WithStackTrace(errNoOtherNode)
+	}
+
+	return s, nil
+}
+
+func (c *Client) hedgingLatencies() *latencies {
+	return c.latencies
+}
+
 func (c *Client) internalPoolWaitFromCh(ctx context.Context, t *trace.Table) (s *session, err error) {
 	var (
 		ch *chan *session
@@ -552,7 +633,7 @@ func (c *Client) Put(ctx context.Context, s *session) (err error) {
 		c.mu.Lock()
 		defer c.mu.Unlock()
 
-		if c.idle.Len() >= c.limit {
+		if c.idle.Len() >= c.limit || len(c.index) > c.limit {
 			return xerrors.WithStackTrace(errSessionPoolOverflow)
 		}
 
@@ -564,6 +645,57 @@ func (c *Client) Put(ctx context.Context, s *session) (err error) {
 	}
 }
 
+// Stats returns snapshot of sessions pool state
+func (c *Client) Stats() table.PoolStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return table.PoolStats{
+		Limit:            c.limit,
+		Index:            len(c.index),
+		Idle:             c.idle.Len(),
+		Busy:             len(c.index) - c.idle.Len(),
+		CreateInProgress: c.createInProgress,
+		Waiters:          c.waitQ.Len(),
+		CreateFailures:   c.createFailures,
+	}
+}
+
+// SetLimit changes upper bound of sessions count in pool.
+// Excess idle sessions closes immediately, excess busy sessions closes on return to pool.
+// Non-positive limit is ignored
+func (c *Client) SetLimit(limit int) {
+	if limit <= 0 {
+		return
+	}
+
+	c.mu.WithLock(func() {
+		if c.isClosed() {
+			return
+		}
+
+		grow := limit - c.limit
+		c.limit = limit
+
+		// waiters retry to create session if pool grows
+		for ; grow > 0 && c.waitQ.Len() > 0; grow-- {
+			c.internalPoolNotify(nil)
+		}
+
+		for excess := len(c.index) - limit; excess > 0 && c.idle.Len() > 0; excess-- {
+			s := c.internalPoolRemoveFirstIdle()
+			s.SetStatus(table.SessionClosing)
+			c.wg.Add(1)
+			go func() {
+				defer c.wg.Done()
+				c.internalPoolSyncCloseSession(context.Background(), s)
+			}()
+		}
+
+		trace.TableOnPoolStateChange(c.config.Trace(), len(c.index), "resize")
+	})
+}
+
 // Close deletes all stored sessions inside Client.
 // It also stops all underlying timers and goroutines.
 // It returns first error occurred during stale sessions' deletion.
@@ -661,6 +661,62 @@ func TestSessionPoolGetPut(t *testing.T) {
 	assertCreated(2)
 }
 
+func TestSessionPoolStatsAndSetLimit(t *testing.T) {
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+						return nil, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(2),
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	s1 := mustGetSession(t, p)
+	s2 := mustGetSession(t, p)
+	require.Equal(t, table.PoolStats{Limit: 2, Index: 2, Busy: 2}, p.Stats())
+
+	// waiter gets session after pool grows
+	got := make(chan *session)
+	go func() {
+		got <- mustGetSession(t, p)
+	}()
+	require.Eventually(t, func() bool {
+		return p.Stats().Waiters == 1
+	}, time.Second, time.Millisecond)
+	p.SetLimit(3)
+	s3 := <-got
+	require.Equal(t, table.PoolStats{Limit: 3, Index: 3, Busy: 3}, p.Stats())
+
+	mustPutSession(t, p, s1)
+	require.Equal(t, table.PoolStats{Limit: 3, Index: 3, Idle: 1, Busy: 2}, p.Stats())
+
+	// excess idle sessions closes immediately, excess busy sessions closes on put
+	p.SetLimit(1)
+	require.Eventually(t, func() bool {
+		return p.Stats().Index == 2
+	}, time.Second, time.Millisecond)
+	require.ErrorIs(t, p.Put(context.Background(), s2), errSessionPoolOverflow)
+	require.Eventually(t, func() bool {
+		return p.Stats().Index == 1
+	}, time.Second, time.Millisecond)
+	mustPutSession(t, p, s3)
+	require.Equal(t, table.PoolStats{Limit: 1, Index: 1, Idle: 1}, p.Stats())
+}
+
 func TestSessionPoolCloseIdleSessions(t *testing.T) {
 	xtest.TestManyTimes(t, func(t testing.TB) {
 		var (
@@ -135,6 +135,19 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithPreparedStatementsCacheSize enables per-session LRU cache of prepared statements keyed by query text.
+// Session.Execute uses cached prepared statement for repeated query text instead of query compilation.
+// If size is less than or equal to zero then cache is disabled
+func WithPreparedStatementsCacheSize(size int) Option {
+	return func(c *Config) {
+		if size > 0 {
+			c.preparedStatementsCacheSize = size
+		} else {
+			c.preparedStatementsCacheSize = 0
+		}
+	}
+}
+
 // WithClock replaces default clock
 func WithClock(clock clockwork.Clock) Option {
 	return func(c *Config) {
@@ -154,6 +167,8 @@ type Config struct {
 
 	ignoreTruncated bool
 
+	preparedStatementsCacheSize int
+
 	trace *trace.Table
 
 	clock clockwork.Clock
@@ -191,6 +206,12 @@ func (c *Config) IgnoreTruncated() bool {
 	return c.ignoreTruncated
 }
 
+// PreparedStatementsCacheSize is a size of per-session LRU cache of prepared statements.
+// If PreparedStatementsCacheSize is zero then cache is disabled
+func (c *Config) PreparedStatementsCacheSize() int {
+	return c.preparedStatementsCacheSize
+}
+
 // IdleKeepAliveThreshold is a number of keepAlive messages to call before the
 // session is removed if it is an excess session (see KeepAliveMinSize)
 // This means that session will be deleted after the expiration of lifetime = IdleThreshold * IdleKeepAliveThreshold
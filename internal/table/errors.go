@@ -34,6 +34,16 @@ var (
 
 	// errNodeIsNotObservable returned by a Client instance to indicate that required node is not observable
 	errNodeIsNotObservable = xerrors.Wrap(errors.New("node is not observable"))
+
+	// errNoOtherNode returned by a Client instance to indicate that there is no other node for hedged request
+	errNoOtherNode = xerrors.Wrap(errors.New("no other node"))
+
+	// errClosedBulkWriter returned by a bulkWriter instance to indicate that bulkWriter is closed
+	errClosedBulkWriter = xerrors.Wrap(errors.New("bulk writer closed"))
+
+	// errStreamNotResumable returned by a resumable stream to indicate that stream cannot continue
+	// after delivered rows
+	errStreamNotResumable = xerrors.Wrap(errors.New("stream is not resumable"))
 )
 
 func isCreateSessionErrorRetriable(err error) bool {
@@ -52,3 +62,19 @@ func isCreateSessionErrorRetriable(err error) bool {
 		return false
 	}
 }
+
+// issueCodePreparedQueryNotFound is an issue code of NOT_FOUND error about query id
+// which is missing in query cache of session
+const issueCodePreparedQueryNotFound = 2019
+
+// isPreparedQueryNotFound reports that prepared query was evicted on server side.
+// Other NOT_FOUND errors (such as missing transaction) are not related to prepared query
+func isPreparedQueryNotFound(err error) (notFound bool) {
+	if !xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+		return false
+	}
+	xerrors.IterateByIssues(err, func(_ string, code Ydb.StatusIds_StatusCode, severity uint32) {
+		notFound = notFound || code == issueCodePreparedQueryNotFound
+	})
+	return notFound
+}
@@ -0,0 +1,169 @@
+package table
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/hedging"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+const (
+	// hedgingLatenciesWindow is a count of last observed latencies for estimate hedging delay
+	hedgingLatenciesWindow = 128
+
+	// hedgingMinLatencies is a minimal count of observed latencies for estimate hedging delay.
+	// Operations calls without hedging while latencies are not enough
+	hedgingMinLatencies = 16
+)
+
+// hedgingSessionProvider is a SessionProvider which supports hedged requests
+type hedgingSessionProvider interface {
+	SessionProvider
+
+	// getOnOtherNode returns session on node other than nodeID or error if there is no other node
+	getOnOtherNode(ctx context.Context, nodeID uint32) (*session, error)
+
+	// hedgingLatencies returns latencies of successful operations calls
+	hedgingLatencies() *latencies
+}
+
+// latencies keeps window of last observed latencies
+type latencies struct {
+	mu     sync.Mutex
+	values []time.Duration
+	next   int
+}
+
+func newLatencies() *latencies {
+	return &latencies{
+		values: make([]time.Duration, 0, hedgingLatenciesWindow),
+	}
+}
+
+func (l *latencies) observe(latency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.values) < cap(l.values) {
+		l.values = append(l.values, latency)
+	} else {
+		l.values[l.next] = latency
+	}
+	l.next = (l.next + 1) % cap(l.values)
+}
+
+// percentile returns latency percentile (from 0 to 100) of observed latencies.
+// ok is false if observed latencies are not enough
+func (l *latencies) percentile(percentile float64) (latency time.Duration, ok bool) {
+	l.mu.Lock()
+	values := make([]time.Duration, len(l.values))
+	copy(values, l.values)
+	l.mu.Unlock()
+
+	if len(values) < hedgingMinLatencies {
+		return 0, false
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+
+	i := int(percentile / 100 * float64(len(values)))
+	if i >= len(values) {
+		i = len(values) - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	return values[i], true
+}
+
+// hedge calls op on session and calls op on session of other node if first call is not done
+// during latency percentile of previous calls. Returns result of first successful call.
+// Each attempt calls with own context, only the winner attempt saves results with hedging.Save.
+// Other call cancels, hedge waits it before return. Error of canceled call does not break its session
+func hedge(
+	ctx context.Context,
+	p hedgingSessionProvider,
+	percentile float64,
+	op table.Operation,
+) (err error) {
+	type attemptResult struct {
+		attempt int
+		err     error
+		latency time.Duration
+	}
+
+	var (
+		delay, canHedge = p.hedgingLatencies().percentile(percentile)
+		results         = make(chan attemptResult, 2)
+		race            hedging.Race
+		attempts        = 0
+		inflight        = 0
+	)
+
+	// sessions must be put to pool with parent context: canceled call context breaks closing of session
+	parentCtx := ctx
+	ctx, cancel := xcontext.WithCancel(ctx)
+	defer func() {
+		cancel()
+		for ; inflight > 0; inflight-- {
+			<-results
+		}
+	}()
+
+	call := func(s *session) {
+		attempts++
+		inflight++
+		go func(attempt int) {
+			start := time.Now()
+			err := op(race.WithAttempt(ctx, attempt), s)
+			latency := time.Since(start)
+			if err != nil && ctx.Err() == nil {
+				s.checkError(err)
+			}
+			_ = p.Put(parentCtx, s)
+			results <- attemptResult{attempt: attempt, err: err, latency: latency}
+		}(attempts)
+	}
+
+	s, err := p.Get(ctx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	call(s)
+
+	var hedgeC <-chan time.Time
+	if canHedge {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		hedgeC = timer.C
+	}
+
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			if other, err := p.getOnOtherNode(ctx, s.NodeID()); err == nil {
+				call(other)
+			}
+		case r := <-results:
+			inflight--
+			switch {
+			case r.err == nil && race.Win(r.attempt):
+				p.hedgingLatencies().observe(r.latency)
+				return nil
+			case r.err != nil && race.IsWinner(r.attempt):
+				// attempt saved results and failed after that
+				return xerrors.WithStackTrace(r.err)
+			case inflight == 0:
+				return xerrors.WithStackTrace(r.err)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package hedging contains synchronization of concurrent attempts of hedged operations
+package hedging
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	ctxAttemptKey       struct{}
+	ctxResultHandOffKey struct{}
+)
+
+type attempt struct {
+	race *Race
+	id   int
+}
+
+// Race chooses single winner from concurrent attempts of hedged operation.
+// Only the winner may publish results of operation
+type Race struct {
+	mu     sync.Mutex
+	winner int // zero if there is no winner yet
+}
+
+// WithAttempt returns context of attempt with id. Id must be positive
+func (r *Race) WithAttempt(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, ctxAttemptKey{}, attempt{race: r, id: id})
+}
+
+// Win makes attempt the winner if there is no other winner and reports whether attempt is the winner
+func (r *Race) Win(id int) bool {
+	return r.win(id, nil)
+}
+
+// IsWinner reports whether attempt is the winner
+func (r *Race) IsWinner(id int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.winner == id
+}
+
+func (r *Race) win(id int, save func()) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.winner != 0 && r.winner != id {
+		return false
+	}
+	r.winner = id
+	if save != nil {
+		save()
+	}
+	return true
+}
+
+// WithResultHandOff returns context of operation which results are handed off by Save.
+// Only such operations may call concurrently with hedging
+func WithResultHandOff(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxResultHandOffKey{}, true)
+}
+
+// IsResultHandOff reports whether results of operation with ctx are handed off by Save
+func IsResultHandOff(ctx context.Context) bool {
+	handOff, _ := ctx.Value(ctxResultHandOffKey{}).(bool)
+	return handOff
+}
+
+// Save calls save if attempt of ctx wins the race or ctx is not a context of hedged attempt.
+// Save reports whether save was called
+func Save(ctx context.Context, save func()) bool {
+	a, ok := ctx.Value(ctxAttemptKey{}).(attempt)
+	if !ok {
+		save()
+		return true
+	}
+	return a.race.win(a.id, save)
+}
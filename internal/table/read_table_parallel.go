@@ -0,0 +1,274 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const defaultReadTableParallelConcurrency = 4
+
+// ReadTableParallel reads table at path with concurrent StreamReadTable calls over table partitions
+func (c *Client) ReadTableParallel(
+	ctx context.Context,
+	path string,
+	f func(ctx context.Context, partition int, res result.Result) error,
+	opts ...options.ReadTableParallelOption,
+) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+	if c.isClosed() {
+		return xerrors.WithStackTrace(errClosedClient)
+	}
+
+	settings := options.ReadTableParallelSettings{
+		Concurrency: defaultReadTableParallelConcurrency,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyReadTableParallelOption(&settings)
+		}
+	}
+	if settings.Concurrency <= 0 {
+		settings.Concurrency = 1
+	}
+
+	var desc options.Description
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		desc, err = s.DescribeTable(ctx, path, options.WithShardKeyBounds())
+		return err
+	}, table.WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	// read must be ordered because read of partition resumes after the last read key
+	readOpts := append(readTableWithPrimaryKey(desc.PrimaryKey, settings.ReadTableOptions), options.ReadOrdered())
+
+	ctx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		inflight = make(chan struct{}, settings.Concurrency)
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	for i := 0; i < len(desc.KeyRanges) && ctx.Err() == nil; i++ {
+		select {
+		case <-ctx.Done():
+			continue
+		case inflight <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(partition int, keyRange options.KeyRange) {
+			defer func() {
+				<-inflight
+				wg.Done()
+			}()
+
+			err := c.readTablePartition(ctx, path, partition, keyRange, desc.PrimaryKey, readOpts, f)
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if firstErr == nil {
+					firstErr = err
+				}
+				cancel()
+			}
+		}(i, desc.KeyRanges[i])
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return xerrors.WithStackTrace(firstErr)
+	}
+	if err = ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// readTablePartition reads rows of key range with retries. Read resumes from the last read key on retry,
+// row limit of read decreases by count of already read rows
+func (c *Client) readTablePartition(
+	ctx context.Context,
+	path string,
+	partition int,
+	keyRange options.KeyRange,
+	primaryKey []string,
+	opts []options.ReadTableOption,
+	f func(ctx context.Context, partition int, res result.Result) error,
+) error {
+	var (
+		lastKey  types.Value
+		read     uint64
+		rowLimit = readTableRowLimit(opts)
+	)
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		opts := append(opts[:len(opts):len(opts)], readTablePartitionOption{
+			keyRange: keyRange,
+			lastKey:  lastKey,
+		})
+		if rowLimit > 0 {
+			if read >= rowLimit {
+				return nil
+			}
+			opts = append(opts, options.ReadRowLimit(rowLimit-read))
+		}
+		recv, onClose, err := s.(*session).streamReadTable(ctx, path, opts...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			_ = onClose(err)
+		}()
+
+		for {
+			set, _, err := recv(ctx)
+			if err != nil {
+				if xerrors.Is(err, io.EOF) {
+					return nil
+				}
+				return xerrors.WithStackTrace(err)
+			}
+			if len(set.GetRows()) == 0 {
+				continue
+			}
+			key, err := lastRowKey(set, primaryKey)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			err = f(ctx, partition, scanner.NewUnary(
+				[]*Ydb.ResultSet{set},
+				nil,
+				scanner.WithIgnoreTruncated(true),
+			))
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			lastKey = key
+			read += uint64(len(set.GetRows()))
+		}
+	}, table.WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// readTableWithPrimaryKey appends primary key columns to read columns if read columns are defined
+func readTableWithPrimaryKey(primaryKey []string, opts []options.ReadTableOption) []options.ReadTableOption {
+	var (
+		a    = allocator.New()
+		desc options.ReadTableDesc
+	)
+	defer a.Free()
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyReadTableOption(&desc, a)
+		}
+	}
+	if len(desc.Columns) == 0 {
+		return opts
+	}
+
+	columns := make(map[string]struct{}, len(desc.Columns))
+	for _, column := range desc.Columns {
+		columns[column] = struct{}{}
+	}
+	var missing []string
+	for _, column := range primaryKey {
+		if _, has := columns[column]; !has {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) == 0 {
+		return opts
+	}
+
+	return append(opts[:len(opts):len(opts)], options.ReadColumns(missing...))
+}
+
+// lastRowKey returns primary key of the last row of result set as tuple of optional values
+func lastRowKey(set *Ydb.ResultSet, primaryKey []string) (types.Value, error) {
+	var (
+		columns = set.GetColumns()
+		row     = set.GetRows()[len(set.GetRows())-1]
+		key     = make([]types.Value, 0, len(primaryKey))
+	)
+	for _, name := range primaryKey {
+		i := columnIndex(columns, name)
+		if i < 0 {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("result set has no primary key column '%s'", name))
+		}
+		t := columns[i].GetType()
+		if t.GetOptionalType() == nil {
+			t = &Ydb.Type{
+				Type: &Ydb.Type_OptionalType{
+					OptionalType: &Ydb.OptionalType{Item: t},
+				},
+			}
+		}
+		key = append(key, value.FromYDB(t, row.GetItems()[i]))
+	}
+
+	return types.TupleValue(key...), nil
+}
+
+func columnIndex(columns []*Ydb.Column, name string) int {
+	for i := range columns {
+		if columns[i].GetName() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// readTablePartitionOption replaces key range of ReadTable request with key range of partition.
+// If lastKey is defined - read starts after lastKey
+type readTablePartitionOption struct {
+	keyRange options.KeyRange
+	lastKey  types.Value
+}
+
+func (o readTablePartitionOption) ApplyReadTableOption(desc *options.ReadTableDesc, a *allocator.Allocator) {
+	desc.KeyRange = &Ydb_Table.KeyRange{}
+	switch {
+	case o.lastKey != nil:
+		desc.KeyRange.FromBound = &Ydb_Table.KeyRange_Greater{
+			Greater: value.ToYDB(o.lastKey, a),
+		}
+	case o.keyRange.From != nil:
+		desc.KeyRange.FromBound = &Ydb_Table.KeyRange_GreaterOrEqual{
+			GreaterOrEqual: value.ToYDB(o.keyRange.From, a),
+		}
+	}
+	if o.keyRange.To != nil {
+		desc.KeyRange.ToBound = &Ydb_Table.KeyRange_Less{
+			Less: value.ToYDB(o.keyRange.To, a),
+		}
+	}
+}
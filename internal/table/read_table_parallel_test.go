@@ -0,0 +1,182 @@
+package table
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+// resultSetsStream is a stream of StreamReadTable or StreamExecuteScanQuery call which
+// responses with result sets of handle and then with error of handle or io.EOF
+type resultSetsStream struct {
+	grpc.ClientStream
+
+	handle func(request proto.Message) ([]*Ydb.ResultSet, error)
+	sets   []*Ydb.ResultSet
+	err    error
+}
+
+func (s *resultSetsStream) SendMsg(m interface{}) error {
+	s.sets, s.err = s.handle(proto.Clone(m.(proto.Message)))
+	return nil
+}
+
+func (s *resultSetsStream) CloseSend() error {
+	return nil
+}
+
+func (s *resultSetsStream) RecvMsg(m interface{}) error {
+	if len(s.sets) == 0 {
+		if s.err != nil {
+			return s.err
+		}
+		return io.EOF
+	}
+	switch response := m.(type) {
+	case *Ydb_Table.ReadTableResponse:
+		response.Result = &Ydb_Table.ReadTableResult{ResultSet: s.sets[0]}
+	case *Ydb_Table.ExecuteScanQueryPartialResponse:
+		response.Result = &Ydb_Table.ExecuteScanQueryPartialResult{ResultSet: s.sets[0]}
+	}
+	s.sets = s.sets[1:]
+	return nil
+}
+
+func uint64ResultSet(a *allocator.Allocator, ids ...uint64) *Ydb.ResultSet {
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{{
+			Name: "id",
+			Type: value.TypeToYDB(types.TypeUint64, a),
+		}},
+	}
+	for _, id := range ids {
+		set.Rows = append(set.Rows, &Ydb.Value{
+			Items: []*Ydb.Value{value.ToYDB(types.Uint64Value(id), a).GetValue()},
+		})
+	}
+	return set
+}
+
+func TestReadTableParallel(t *testing.T) {
+	var (
+		ctx  = xtest.Context(t)
+		a    = allocator.New()
+		rows = func(ids ...uint64) *Ydb.ResultSet {
+			return uint64ResultSet(a, ids...)
+		}
+		bound = value.ToYDB(types.TupleValue(types.OptionalValue(types.Uint64Value(10))), a)
+
+		mu       sync.Mutex
+		requests []*Ydb_Table.ReadTableRequest
+		read     = map[int][]uint64{}
+	)
+	defer a.Free()
+
+	client := New(
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+				testutil.TableCreateSession: func(request interface{}) (proto.Message, error) {
+					return &Ydb_Table.CreateSessionResult{}, nil
+				},
+				testutil.TableDeleteSession: func(request interface{}) (proto.Message, error) {
+					return &Ydb_Table.DeleteSessionResponse{}, nil
+				},
+				testutil.TableDescribeTable: func(request interface{}) (proto.Message, error) {
+					return &Ydb_Table.DescribeTableResult{
+						PrimaryKey: []string{"id"},
+						Columns: []*Ydb_Table.ColumnMeta{{
+							Name: "id",
+							Type: value.TypeToYDB(types.TypeUint64, a),
+						}},
+						ShardKeyBounds: []*Ydb.TypedValue{bound},
+					}, nil
+				},
+			}),
+			testutil.WithNewStreamHandlers(testutil.NewStreamHandlers{
+				testutil.TableStreamReadTable: func(desc *grpc.StreamDesc) (grpc.ClientStream, error) {
+					return &resultSetsStream{
+						handle: func(m proto.Message) ([]*Ydb.ResultSet, error) {
+							mu.Lock()
+							defer mu.Unlock()
+
+							request := m.(*Ydb_Table.ReadTableRequest)
+							requests = append(requests, request)
+							switch {
+							case request.GetKeyRange().GetLess() == nil:
+								return []*Ydb.ResultSet{rows(10, 11)}, nil
+							case request.GetKeyRange().GetGreater() == nil:
+								// first read of partition fails after first chunk
+								return []*Ydb.ResultSet{rows(1, 2)}, xerrors.Operation(
+									xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE),
+								)
+							default:
+								return []*Ydb.ResultSet{rows(3)}, nil
+							}
+						},
+					}, nil
+				},
+			}),
+		),
+		config.New(),
+	)
+	defer func() {
+		_ = client.Close(ctx)
+	}()
+
+	err := client.ReadTableParallel(ctx, "series",
+		func(ctx context.Context, partition int, res result.Result) error {
+			for res.NextResultSet(ctx) {
+				for res.NextRow() {
+					var id uint64
+					if err := res.Scan(&id); err != nil {
+						return err
+					}
+					mu.Lock()
+					read[partition] = append(read[partition], id)
+					mu.Unlock()
+				}
+			}
+			return res.Err()
+		},
+		options.WithReadTableParallelReadOptions(options.ReadRowLimit(5)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[int][]uint64{0: {1, 2, 3}, 1: {10, 11}}, read)
+
+	require.Len(t, requests, 3)
+	for _, r := range requests {
+		require.True(t, r.GetOrdered())
+	}
+
+	// read of first partition resumes after the last read key
+	var resumed *Ydb_Table.ReadTableRequest
+	for _, r := range requests {
+		if r.GetKeyRange().GetGreater() != nil {
+			resumed = r
+		}
+	}
+	require.NotNil(t, resumed)
+	require.EqualValues(t, 3, resumed.GetRowLimit())
+	require.True(t, proto.Equal(bound, resumed.GetKeyRange().GetLess()))
+	require.True(t, proto.Equal(
+		value.ToYDB(types.TupleValue(types.OptionalValue(types.Uint64Value(2))), a),
+		resumed.GetKeyRange().GetGreater(),
+	))
+}
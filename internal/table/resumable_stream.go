@@ -0,0 +1,238 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+// maxStreamResumeAttempts limits attempts of reopen stream without progress of read
+const maxStreamResumeAttempts = 10
+
+type (
+	// streamRecv receives next result set from stream
+	streamRecv func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error)
+
+	// streamResume opens stream on session s which continues after delivered rows of previous streams.
+	// last is the last delivered result set with rows, delivered is a count of delivered rows
+	streamResume func(ctx context.Context, s *session, last *Ydb.ResultSet, delivered int) (
+		recv streamRecv, onClose func(err error) error, err error,
+	)
+)
+
+// resumableStream receives result sets from stream and reopens stream after retryable errors.
+// Stream reopens on the same session or on new session if session of stream is broken.
+// New sessions are closed with the stream
+type resumableStream struct {
+	ctx     context.Context //nolint:containedctx
+	origin  *session
+	current *session // session of current stream, nil if it is broken
+	recv    streamRecv
+	onClose func(err error) error
+	resume  streamResume
+
+	last      *Ydb.ResultSet
+	delivered int
+	attempts  int // attempts of reopen stream after the last delivered rows
+}
+
+func (s *session) newResumableStream(
+	ctx context.Context,
+	recv streamRecv,
+	onClose func(err error) error,
+	resume streamResume,
+) (streamRecv, func(err error) error) {
+	rs := &resumableStream{
+		ctx:     ctx,
+		origin:  s,
+		current: s,
+		recv:    recv,
+		onClose: onClose,
+		resume:  resume,
+	}
+	return rs.next, rs.close
+}
+
+func isResumableReadTable(opts []options.ReadTableOption) bool {
+	for _, opt := range opts {
+		if _, ok := opt.(options.ResumableStreamOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isResumableScanQuery(opts []options.ExecuteScanQueryOption) bool {
+	for _, opt := range opts {
+		if _, ok := opt.(options.ResumableStreamOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isResumableStreamError reports that stream can be reopened after err
+func isResumableStreamError(err error) bool {
+	return retry.Check(err).MustRetry(true)
+}
+
+func (s *resumableStream) next(ctx context.Context) (set *Ydb.ResultSet, stats *Ydb_TableStats.QueryStats, err error) {
+	for {
+		set, stats, err = s.recv(ctx)
+		if err == nil {
+			if n := len(set.GetRows()); n > 0 {
+				s.last = set
+				s.delivered += n
+				s.attempts = 0
+			}
+			return set, stats, nil
+		}
+		if xerrors.Is(err, io.EOF) || ctx.Err() != nil || s.ctx.Err() != nil ||
+			!isResumableStreamError(err) || s.attempts >= maxStreamResumeAttempts {
+			return nil, nil, err
+		}
+
+		_ = s.onClose(err)
+		s.onClose = func(err error) error {
+			return err
+		}
+
+		var (
+			recv    streamRecv
+			onClose func(err error) error
+			cause   = err
+			errStop error
+		)
+		errResume := retry.Retry(s.ctx, func(ctx context.Context) (err error) {
+			s.attempts++
+			recv, onClose, err = s.reopen(ctx, cause)
+			if err != nil {
+				cause = err
+				if s.attempts >= maxStreamResumeAttempts || !isResumableStreamError(err) {
+					errStop = err
+					return nil
+				}
+			}
+			return err
+		},
+			retry.WithID("ResumeStream"),
+			retry.WithIdempotent(true),
+			retry.WithBudget(s.origin.config.RetryBudget()),
+			retry.WithTrace(*s.origin.config.RetryTrace()),
+		)
+		if errResume == nil {
+			errResume = errStop
+		}
+		if errResume != nil {
+			return nil, nil, xerrors.WithStackTrace(xerrors.Join(err, errResume))
+		}
+		s.recv, s.onClose = recv, onClose
+	}
+}
+
+// reopen opens stream after error cause of previous stream
+func (s *resumableStream) reopen(ctx context.Context, cause error) (streamRecv, func(err error) error, error) {
+	if s.current != nil && retry.Check(cause).MustDeleteSession() {
+		s.current.checkError(cause)
+		s.releaseSession()
+		s.current = nil
+	}
+	if s.current == nil {
+		session, err := newSession(ctx, s.origin.cc, s.origin.config)
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		s.current = session
+	}
+	return s.resume(ctx, s.current, s.last, s.delivered)
+}
+
+// releaseSession closes current session if it is made for resumed stream
+func (s *resumableStream) releaseSession() {
+	if s.current != nil && s.current != s.origin {
+		_ = s.current.Close(xcontext.WithoutDeadline(s.ctx))
+	}
+}
+
+func (s *resumableStream) close(err error) error {
+	err = s.onClose(err)
+	s.releaseSession()
+	return err
+}
+
+// resumeReadTable returns streamResume which reads rows after primary key of the last delivered row.
+// Read columns must contain primary key columns
+func resumeReadTable(path string, primaryKey []string, opts []options.ReadTableOption) streamResume {
+	rowLimit := readTableRowLimit(opts)
+
+	return func(ctx context.Context, s *session, last *Ydb.ResultSet, delivered int) (
+		streamRecv, func(error) error, error,
+	) {
+		opts := opts[:len(opts):len(opts)]
+		if last != nil {
+			key, err := lastRowKey(last, primaryKey)
+			if err != nil {
+				return nil, nil, xerrors.WithStackTrace(err)
+			}
+			opts = append(opts, options.ReadGreater(key))
+		}
+		if rowLimit > 0 {
+			if uint64(delivered) >= rowLimit {
+				return func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+						return nil, nil, io.EOF
+					}, func(err error) error {
+						return err
+					}, nil
+			}
+			opts = append(opts, options.ReadRowLimit(rowLimit-uint64(delivered)))
+		}
+
+		return s.streamReadTable(ctx, path, opts...)
+	}
+}
+
+// resumeExecuteScanQuery returns streamResume which re-executes scan query if no rows are delivered yet.
+// Re-executed query reads new snapshot of data, so rows after delivered rows cannot be continued
+func resumeExecuteScanQuery(
+	query string,
+	params *table.QueryParameters,
+	opts []options.ExecuteScanQueryOption,
+) streamResume {
+	return func(ctx context.Context, s *session, last *Ydb.ResultSet, delivered int) (
+		streamRecv, func(error) error, error,
+	) {
+		if delivered > 0 {
+			return nil, nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: %d rows of scan query are delivered", errStreamNotResumable, delivered),
+			)
+		}
+
+		return s.streamExecuteScanQuery(ctx, query, params, opts...)
+	}
+}
+
+func readTableRowLimit(opts []options.ReadTableOption) uint64 {
+	var (
+		a    = allocator.New()
+		desc options.ReadTableDesc
+	)
+	defer a.Free()
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyReadTableOption(&desc, a)
+		}
+	}
+
+	return desc.RowLimit
+}
@@ -0,0 +1,229 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+func TestResumableStream(t *testing.T) {
+	var (
+		ctx         = xtest.Context(t)
+		a           = allocator.New()
+		unavailable = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+		readIDs     = func(t *testing.T, res result.StreamResult) (ids []uint64) {
+			defer func() {
+				_ = res.Close()
+			}()
+			for res.NextResultSet(ctx) {
+				for res.NextRow() {
+					var id uint64
+					require.NoError(t, res.Scan(&id))
+					ids = append(ids, id)
+				}
+			}
+			require.NoError(t, res.Err())
+			return ids
+		}
+	)
+	defer a.Free()
+
+	type testCluster struct {
+		sessions []string // created sessions
+		deleted  []string // deleted sessions
+	}
+	newTestSession := func(t *testing.T, handle func(request proto.Message) ([]*Ydb.ResultSet, error)) (
+		*session, *testCluster,
+	) {
+		cluster := &testCluster{}
+		stream := func(desc *grpc.StreamDesc) (grpc.ClientStream, error) {
+			return &resultSetsStream{handle: handle}, nil
+		}
+		b := testutil.NewBalancer(
+			testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+				testutil.TableCreateSession: func(request interface{}) (proto.Message, error) {
+					cluster.sessions = append(cluster.sessions, testutil.SessionID())
+					return &Ydb_Table.CreateSessionResult{
+						SessionId: cluster.sessions[len(cluster.sessions)-1],
+					}, nil
+				},
+				testutil.TableDeleteSession: func(request interface{}) (proto.Message, error) {
+					cluster.deleted = append(cluster.deleted, request.(*Ydb_Table.DeleteSessionRequest).GetSessionId())
+					return &Ydb_Table.DeleteSessionResponse{}, nil
+				},
+				testutil.TableDescribeTable: func(request interface{}) (proto.Message, error) {
+					return &Ydb_Table.DescribeTableResult{
+						PrimaryKey: []string{"id"},
+					}, nil
+				},
+			}),
+			testutil.WithNewStreamHandlers(testutil.NewStreamHandlers{
+				testutil.TableStreamReadTable:        stream,
+				testutil.TableStreamExecuteScanQuery: stream,
+			}),
+		)
+		s, err := newSession(ctx, b, config.New())
+		require.NoError(t, err)
+		return s, cluster
+	}
+
+	t.Run("ReadTable", func(t *testing.T) {
+		var requests []*Ydb_Table.ReadTableRequest
+		s, _ := newTestSession(t, func(m proto.Message) ([]*Ydb.ResultSet, error) {
+			request := m.(*Ydb_Table.ReadTableRequest)
+			requests = append(requests, request)
+			if len(requests) == 1 {
+				return []*Ydb.ResultSet{uint64ResultSet(a, 1, 2)}, unavailable
+			}
+			return []*Ydb.ResultSet{uint64ResultSet(a, 3)}, nil
+		})
+
+		res, err := s.StreamReadTable(ctx, "series",
+			options.ReadRowLimit(3),
+			options.WithResumableStream(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2, 3}, readIDs(t, res))
+
+		require.Len(t, requests, 2)
+		require.True(t, requests[0].GetOrdered())
+		require.Nil(t, requests[0].GetKeyRange())
+		require.EqualValues(t, 1, requests[1].GetRowLimit())
+		require.NotNil(t, requests[1].GetKeyRange().GetGreater())
+	})
+
+	for _, test := range []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "BadSession",
+			err:  xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION)),
+		},
+		{
+			name: "Transport",
+			err:  xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var sessions []string
+			s, cluster := newTestSession(t, func(m proto.Message) ([]*Ydb.ResultSet, error) {
+				sessions = append(sessions, m.(*Ydb_Table.ReadTableRequest).GetSessionId())
+				if len(sessions) == 1 {
+					return []*Ydb.ResultSet{uint64ResultSet(a, 1)}, test.err
+				}
+				return []*Ydb.ResultSet{uint64ResultSet(a, 2)}, nil
+			})
+
+			res, err := s.StreamReadTable(ctx, "series", options.WithResumableStream())
+			require.NoError(t, err)
+			require.Equal(t, []uint64{1, 2}, readIDs(t, res))
+
+			require.Len(t, cluster.sessions, 2)
+			require.Equal(t, cluster.sessions, sessions, "stream is resumed on new session")
+			require.True(t, s.isClosing(), "broken session is marked for delete")
+			require.Equal(t, cluster.sessions[1:], cluster.deleted, "new session is closed with stream")
+		})
+	}
+
+	t.Run("ReadColumnsWithoutPrimaryKey", func(t *testing.T) {
+		var requests []*Ydb_Table.ReadTableRequest
+		s, _ := newTestSession(t, func(m proto.Message) ([]*Ydb.ResultSet, error) {
+			request := m.(*Ydb_Table.ReadTableRequest)
+			requests = append(requests, request)
+			if len(requests) == 1 {
+				return []*Ydb.ResultSet{uint64ResultSet(a, 1)}, unavailable
+			}
+			return []*Ydb.ResultSet{uint64ResultSet(a, 2)}, nil
+		})
+
+		res, err := s.StreamReadTable(ctx, "series",
+			options.ReadColumn("value"),
+			options.WithResumableStream(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2}, readIDs(t, res))
+
+		require.Len(t, requests, 2)
+		for _, request := range requests {
+			require.Equal(t, []string{"value", "id"}, request.GetColumns())
+		}
+		require.NotNil(t, requests[1].GetKeyRange().GetGreater())
+	})
+
+	t.Run("ScanQuery", func(t *testing.T) {
+		t.Run("NoRowsDelivered", func(t *testing.T) {
+			calls := 0
+			s, _ := newTestSession(t, func(m proto.Message) ([]*Ydb.ResultSet, error) {
+				calls++
+				if calls == 1 {
+					return nil, unavailable
+				}
+				return []*Ydb.ResultSet{uint64ResultSet(a, 1, 2)}, nil
+			})
+
+			res, err := s.StreamExecuteScanQuery(ctx, "SELECT id FROM series", nil, options.WithResumableStream())
+			require.NoError(t, err)
+			require.Equal(t, []uint64{1, 2}, readIDs(t, res))
+			require.Equal(t, 2, calls)
+		})
+		t.Run("RowsDelivered", func(t *testing.T) {
+			calls := 0
+			s, _ := newTestSession(t, func(m proto.Message) ([]*Ydb.ResultSet, error) {
+				calls++
+				return []*Ydb.ResultSet{uint64ResultSet(a, 1)}, unavailable
+			})
+
+			res, err := s.StreamExecuteScanQuery(ctx, "SELECT id FROM series", nil, options.WithResumableStream())
+			require.NoError(t, err)
+			require.True(t, res.NextResultSet(ctx))
+			require.False(t, res.NextResultSet(ctx))
+			require.ErrorIs(t, res.Err(), errStreamNotResumable)
+			require.Equal(t, 1, calls)
+		})
+	})
+
+	t.Run("ResumeAttemptsLimit", func(t *testing.T) {
+		calls := 0
+		s, _ := newTestSession(t, func(m proto.Message) ([]*Ydb.ResultSet, error) {
+			calls++
+			if calls == 1 {
+				return []*Ydb.ResultSet{uint64ResultSet(a, 1)}, unavailable
+			}
+			return nil, unavailable
+		})
+
+		res, err := s.StreamReadTable(ctx, "series", options.WithResumableStream())
+		require.NoError(t, err)
+		require.True(t, res.NextResultSet(ctx))
+		require.False(t, res.NextResultSet(ctx))
+		require.Error(t, res.Err())
+		require.Equal(t, 1+maxStreamResumeAttempts, calls)
+	})
+
+	t.Run("NotResumable", func(t *testing.T) {
+		s, _ := newTestSession(t, func(m proto.Message) ([]*Ydb.ResultSet, error) {
+			return []*Ydb.ResultSet{uint64ResultSet(a, 1)}, unavailable
+		})
+
+		res, err := s.StreamReadTable(ctx, "series")
+		require.NoError(t, err)
+		require.True(t, res.NextResultSet(ctx))
+		require.False(t, res.NextResultSet(ctx))
+		require.Error(t, res.Err())
+	})
+}
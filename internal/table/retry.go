@@ -2,11 +2,15 @@ package table
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/hedging"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -61,7 +65,10 @@ func doTx(
 		c,
 		opts.FastBackoff,
 		opts.SlowBackoff,
+		config.RetryBudget(),
+		config.RetryTrace(),
 		opts.Idempotent,
+		0,
 		func(ctx context.Context, s table.Session) (err error) {
 			attempts++
 
@@ -127,15 +134,25 @@ func do(
 	if opts.Trace == nil {
 		opts.Trace = &trace.Table{}
 	}
-	attempts, onIntermediate := 0, trace.TableOnDo(opts.Trace, &ctx, opts.Idempotent, isRetryCalledAbove(ctx))
+	var (
+		attempts, onIntermediate = 0, trace.TableOnDo(opts.Trace, &ctx, opts.Idempotent, isRetryCalledAbove(ctx))
+		// attempts of hedged requests calls concurrently
+		mu sync.Mutex
+	)
 	defer func() {
 		onIntermediate(err)(attempts, err)
 	}()
-	return retryBackoff(ctx, c, opts.FastBackoff, opts.SlowBackoff, opts.Idempotent,
+	return retryBackoff(ctx, c, opts.FastBackoff, opts.SlowBackoff, config.RetryBudget(),
+		config.RetryTrace(), opts.Idempotent, opts.HedgingPercentile,
 		func(ctx context.Context, s table.Session) (err error) {
+			mu.Lock()
 			attempts++
+			mu.Unlock()
 
 			defer func() {
+				mu.Lock()
+				defer mu.Unlock()
+
 				onIntermediate(err)
 			}()
 
@@ -164,11 +181,19 @@ func retryBackoff(
 	p SessionProvider,
 	fastBackoff backoff.Backoff,
 	slowBackoff backoff.Backoff,
+	retryBudget budget.Budget,
+	retryTrace *trace.Retry,
 	isOperationIdempotent bool,
+	hedgingPercentile float64,
 	op table.Operation,
 ) (err error) {
+	h, isHedgingProvider := p.(hedgingSessionProvider)
 	err = retry.Retry(markRetryCall(ctx),
 		func(ctx context.Context) (err error) {
+			if isHedgingProvider && isOperationIdempotent && hedgingPercentile > 0 && hedging.IsResultHandOff(ctx) {
+				return hedge(ctx, h, hedgingPercentile, op)
+			}
+
 			var s *session
 
 			s, err = p.Get(ctx)
@@ -180,16 +205,22 @@ func retryBackoff(
 				_ = p.Put(ctx, s)
 			}()
 
+			start := time.Now()
 			err = op(ctx, s)
 			if err != nil {
 				s.checkError(err)
 				return xerrors.WithStackTrace(err)
 			}
+			if isHedgingProvider {
+				h.hedgingLatencies().observe(time.Since(start))
+			}
 
 			return nil
 		},
 		retry.WithFastBackoff(fastBackoff),
 		retry.WithSlowBackoff(slowBackoff),
+		retry.WithBudget(retryBudget),
+		retry.WithTrace(*retryTrace),
 		retry.WithIdempotent(isOperationIdempotent),
 	)
 	if err != nil {
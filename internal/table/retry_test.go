@@ -7,17 +7,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
+	internalConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/hedging"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestRetryerBackoffRetryCancelation(t *testing.T) {
@@ -127,6 +133,41 @@ func TestRetryerBadSession(t *testing.T) {
 	}
 }
 
+type noQuotaBudget struct{}
+
+func (noQuotaBudget) Acquire(ctx context.Context) error {
+	return budget.ErrNoQuota
+}
+
+func TestRetryBudgetExhaustedTrace(t *testing.T) {
+	var (
+		common    internalConfig.Common
+		exhausted []trace.RetryBudgetExhaustedInfo
+	)
+	internalConfig.SetRetryBudget(&common, noQuotaBudget{})
+	internalConfig.SetRetryTrace(&common, &trace.Retry{
+		OnBudgetExhausted: func(info trace.RetryBudgetExhaustedInfo) {
+			exhausted = append(exhausted, info)
+		},
+	})
+	p := SessionProviderFunc{
+		OnGet: func(ctx context.Context) (*session, error) {
+			return simpleSession(t), nil
+		},
+	}
+	errRetryable := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+	err := do(context.Background(), p, config.New(config.With(common)),
+		func(ctx context.Context, s table.Session) error {
+			return errRetryable
+		},
+		&table.Options{},
+	)
+	require.ErrorIs(t, err, budget.ErrNoQuota)
+	require.Len(t, exhausted, 1)
+	require.Equal(t, 1, exhausted[0].Attempts)
+	require.ErrorIs(t, exhausted[0].Error, errRetryable)
+}
+
 func TestRetryerSessionClosing(t *testing.T) {
 	closed := make(map[table.Session]bool)
 	p := SessionProviderFunc{
@@ -527,3 +568,225 @@ var (
 	errUnexpectedSession = xerrors.Wrap(fmt.Errorf("unexpected session"))
 	errSessionOverflow   = xerrors.Wrap(fmt.Errorf("session overflow"))
 )
+
+type hedgingSessions struct {
+	SessionProviderFunc
+
+	OnGetOnOtherNode func(ctx context.Context, nodeID uint32) (*session, error)
+	latencies        *latencies
+}
+
+var _ hedgingSessionProvider = (*hedgingSessions)(nil)
+
+func (p *hedgingSessions) getOnOtherNode(ctx context.Context, nodeID uint32) (*session, error) {
+	return p.OnGetOnOtherNode(ctx, nodeID)
+}
+
+func (p *hedgingSessions) hedgingLatencies() *latencies {
+	return p.latencies
+}
+
+func TestRetryHedging(t *testing.T) {
+	var (
+		slow = simpleSession(t)
+		fast = simpleSession(t)
+		p    = &hedgingSessions{
+			SessionProviderFunc: SessionProviderFunc{
+				OnGet: func(ctx context.Context) (*session, error) {
+					return slow, nil
+				},
+				OnPut: func(ctx context.Context, s *session) error {
+					return nil
+				},
+			},
+			OnGetOnOtherNode: func(ctx context.Context, nodeID uint32) (*session, error) {
+				return fast, nil
+			},
+			latencies: newLatencies(),
+		}
+		slowCanceled = make(chan struct{})
+	)
+	for i := 0; i < hedgingMinLatencies; i++ {
+		p.latencies.observe(time.Millisecond)
+	}
+	err := do(hedging.WithResultHandOff(context.Background()), p, config.New(),
+		func(ctx context.Context, s table.Session) error {
+			if s == slow {
+				<-ctx.Done()
+				close(slowCanceled)
+				return xerrors.Transport(grpcStatus.Error(grpcCodes.Canceled, ctx.Err().Error()))
+			}
+			return nil
+		},
+		&table.Options{
+			Idempotent:        true,
+			HedgingPercentile: 90,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-slowCanceled:
+	default:
+		t.Fatal("slow call not canceled before return")
+	}
+	if slow.Status() == table.SessionClosing {
+		t.Fatal("canceled call broke session")
+	}
+}
+
+func TestRetryHedgingBothSucceed(t *testing.T) {
+	var (
+		slow = simpleSession(t)
+		fast = simpleSession(t)
+		p    = &hedgingSessions{
+			SessionProviderFunc: SessionProviderFunc{
+				OnGet: func(ctx context.Context) (*session, error) {
+					return slow, nil
+				},
+				OnPut: func(ctx context.Context, s *session) error {
+					return nil
+				},
+			},
+			OnGetOnOtherNode: func(ctx context.Context, nodeID uint32) (*session, error) {
+				return fast, nil
+			},
+			latencies: newLatencies(),
+		}
+		fastSaved = make(chan struct{})
+		result    *session
+	)
+	for i := 0; i < hedgingMinLatencies; i++ {
+		p.latencies.observe(time.Millisecond)
+	}
+	err := do(hedging.WithResultHandOff(context.Background()), p, config.New(),
+		func(ctx context.Context, s table.Session) error {
+			if s == slow {
+				<-fastSaved
+				if hedging.Save(ctx, func() { result = slow }) {
+					t.Error("loser attempt saved result")
+				}
+				return nil
+			}
+			if !hedging.Save(ctx, func() { result = fast }) {
+				t.Error("winner attempt not saved result")
+			}
+			close(fastSaved)
+			return nil
+		},
+		&table.Options{
+			Idempotent:        true,
+			HedgingPercentile: 90,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != fast {
+		t.Fatal("unexpected result of hedged operation")
+	}
+}
+
+func TestRetryHedgingWithoutResultHandOff(t *testing.T) {
+	p := &hedgingSessions{
+		SessionProviderFunc: SessionProviderFunc{
+			OnGet: func(ctx context.Context) (*session, error) {
+				return simpleSession(t), nil
+			},
+			OnPut: func(ctx context.Context, s *session) error {
+				return nil
+			},
+		},
+		OnGetOnOtherNode: func(ctx context.Context, nodeID uint32) (*session, error) {
+			t.Error("operation without result hand-off is hedged")
+			return nil, errNoOtherNode
+		},
+		latencies: newLatencies(),
+	}
+	for i := 0; i < hedgingMinLatencies; i++ {
+		err := do(context.Background(), p, config.New(),
+			func(ctx context.Context, s table.Session) error {
+				return nil
+			},
+			&table.Options{
+				Idempotent:        true,
+				HedgingPercentile: 10,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := p.latencies.percentile(90); !ok {
+		t.Fatal("latencies of calls without hedging are not observed")
+	}
+}
+
+func TestClientGetOnOtherNode(t *testing.T) {
+	newNodeSession := func(nodeID uint32) *session {
+		return &session{
+			id:     fmt.Sprintf("ydb://session/3?node_id=%d&id=test", nodeID),
+			config: config.New(),
+			status: table.SessionReady,
+		}
+	}
+
+	t.Run("NoOtherNode", func(t *testing.T) {
+		c := newClientWithStubBuilder(t, testutil.NewBalancer(), 0)
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+		c.nodes = nodeIDs{1}
+
+		_, err := c.getOnOtherNode(context.Background(), 1)
+		if !xerrors.Is(err, errNoOtherNode) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("RouteToOtherNode", func(t *testing.T) {
+		c := newClient(testutil.NewBalancer(), func(ctx context.Context) (*session, error) {
+			e, ok := balancerContext.ContextEndpoint(ctx)
+			if !ok {
+				return nil, fmt.Errorf("no endpoint in context")
+			}
+			return newNodeSession(e.NodeID()), nil
+		}, config.New())
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+		c.nodes = nodeIDs{1, 2}
+
+		s, err := c.getOnOtherNode(context.Background(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.NodeID() != 2 {
+			t.Fatalf("unexpected node of session: %d", s.NodeID())
+		}
+	})
+}
+
+type nodeIDs []uint32
+
+func (ids nodeIDs) NodeIDs() []uint32 {
+	return ids
+}
+
+func TestLatenciesPercentile(t *testing.T) {
+	l := newLatencies()
+	if _, ok := l.percentile(50); ok {
+		t.Fatal("percentile without enough latencies")
+	}
+	for i := 1; i <= hedgingLatenciesWindow*2; i++ {
+		l.observe(time.Duration(i) * time.Millisecond)
+	}
+	latency, ok := l.percentile(50)
+	if !ok {
+		t.Fatal("no percentile")
+	}
+	if exp := time.Duration(hedgingLatenciesWindow*3/2+1) * time.Millisecond; latency != exp {
+		t.Fatalf("unexpected percentile: %v, exp: %v", latency, exp)
+	}
+}
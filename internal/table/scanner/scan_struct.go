@@ -0,0 +1,167 @@
+package scanner
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xreflect"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+type structFieldsKey struct {
+	t       reflect.Type
+	tagName string
+}
+
+// structFields caches column names to field indexes mapping of struct types
+var structFields sync.Map // map[structFieldsKey]map[string][]int
+
+// fieldsByColumnName returns indexes of struct fields by column names
+func fieldsByColumnName(t reflect.Type, tagName string) map[string][]int {
+	key := structFieldsKey{t: t, tagName: tagName}
+	if fields, has := structFields.Load(key); has {
+		return fields.(map[string][]int)
+	}
+	fields := make(map[string][]int, t.NumField())
+	collectFields(fields, t, tagName, nil)
+	structFields.Store(key, fields)
+	return fields
+}
+
+func collectFields(fields map[string][]int, t reflect.Type, tagName string, index []int) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, tagged := columnName(f, tagName)
+		if name == "-" {
+			continue
+		}
+		fieldIndex := append(append(make([]int, 0, len(index)+1), index...), i)
+		if f.Anonymous && !tagged && f.Type.Kind() == reflect.Struct {
+			collectFields(fields, f.Type, tagName, fieldIndex)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if _, has := fields[name]; !has {
+			fields[name] = fieldIndex
+		}
+	}
+}
+
+func columnName(f reflect.StructField, tagName string) (name string, tagged bool) {
+	if tagName != "" {
+		return xreflect.FieldName(f, tagName)
+	}
+	return xreflect.FieldName(f)
+}
+
+func (s *scanner) ScanStruct(dst interface{}, opts ...options.ScanStructOption) (err error) {
+	if err = s.Err(); err != nil {
+		return err
+	}
+	if s.nextItem != 0 {
+		panic("scan row failed: double scan per row")
+	}
+	settings := options.ScanStructSettings{}
+	for _, o := range opts {
+		if o != nil {
+			o.ApplyScanStructOption(&settings)
+		}
+	}
+
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return s.errorf(0, "scan struct failed: dst must be a non-nil pointer to struct, got %T", dst)
+	}
+	rv = rv.Elem()
+
+	fields := fieldsByColumnName(rv.Type(), settings.TagName)
+
+	columns := s.columnIndexes
+	if columns == nil {
+		columns = make([]int, s.ColumnCount())
+		for i := range columns {
+			columns[i] = i
+		}
+	}
+
+	scanned := make(map[string]struct{}, len(columns))
+	for _, i := range columns {
+		if err = s.seekItemByID(i); err != nil {
+			return err
+		}
+		name, index, err := lookupField(fields, s.set.Columns[i].Name)
+		if err != nil {
+			return s.errorf(0, "scan struct failed: %w in struct %s", err, rv.Type())
+		}
+		if index == nil {
+			if settings.AllowMissingFieldsInStruct {
+				continue
+			}
+			return s.errorf(0, "scan struct failed: column %q has no field in struct %s", name, rv.Type())
+		}
+		scanned[name] = struct{}{}
+		s.scanField(rv.FieldByIndex(index))
+		if err = s.Err(); err != nil {
+			return err
+		}
+	}
+
+	if !settings.AllowMissingColumnsFromSelect && len(scanned) < len(fields) {
+		missing := make([]string, 0, len(fields)-len(scanned))
+		for name := range fields {
+			if _, has := scanned[name]; !has {
+				missing = append(missing, name)
+			}
+		}
+		sort.Strings(missing)
+		return s.errorf(0, "scan struct failed: columns %q of struct %s are missing in result set", missing, rv.Type())
+	}
+
+	s.nextItem += len(columns)
+
+	return s.Err()
+}
+
+// lookupField finds field by column name. If there is no exact match, names are matched case-insensitively.
+// lookupField returns nil index if there is no field for column and error if column matches several fields
+func lookupField(fields map[string][]int, column string) (name string, index []int, err error) {
+	if index, has := fields[column]; has {
+		return column, index, nil
+	}
+	var matches []string
+	for name := range fields {
+		if strings.EqualFold(name, column) {
+			matches = append(matches, name)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return column, nil, nil
+	case 1:
+		return matches[0], fields[matches[0]], nil
+	default:
+		sort.Strings(matches)
+		return column, nil, fmt.Errorf("column %q matches case-insensitively to several fields %q", column, matches)
+	}
+}
+
+func (s *scanner) scanField(field reflect.Value) {
+	optional := s.isCurrentTypeOptional()
+	switch {
+	case field.Kind() == reflect.Ptr && optional:
+		s.scanOptional(field.Addr().Interface(), false)
+	case field.Kind() == reflect.Ptr:
+		v := reflect.New(field.Type().Elem())
+		s.scanRequired(v.Interface())
+		field.Set(v)
+	case optional:
+		s.scanOptional(field.Addr().Interface(), true)
+	default:
+		s.scanRequired(field.Addr().Interface())
+	}
+}
@@ -0,0 +1,198 @@
+package scanner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type scanStructBase struct {
+	ID uint64 `sql:"id"`
+}
+
+type scanStructRow struct {
+	scanStructBase
+	Title    string  `ydb:"title"`
+	Comment  *string `sql:"comment"`
+	Score    int32   `sql:"score"`
+	Ignored  string  `sql:"-"`
+	internal string
+}
+
+func newScanStructResult(a *allocator.Allocator) UnaryResult {
+	return NewUnary(
+		[]*Ydb.ResultSet{
+			NewResultSet(a,
+				WithColumns(
+					options.Column{Name: "id", Type: types.TypeUint64},
+					options.Column{Name: "title", Type: types.TypeText},
+					options.Column{Name: "comment", Type: types.Optional(types.TypeText)},
+					options.Column{Name: "score", Type: types.Optional(types.TypeInt32)},
+				),
+				WithValues(
+					types.Uint64Value(1),
+					types.TextValue("first"),
+					types.OptionalValue(types.TextValue("comment")),
+					types.OptionalValue(types.Int32Value(10)),
+
+					types.Uint64Value(2),
+					types.TextValue("second"),
+					types.NullValue(types.TypeText),
+					types.NullValue(types.TypeInt32),
+				),
+			),
+		},
+		nil,
+	)
+}
+
+func TestScanStruct(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	res := newScanStructResult(a)
+	var rows []scanStructRow
+	for res.NextResultSet(context.Background()) {
+		for res.NextRow() {
+			var row scanStructRow
+			require.NoError(t, res.ScanStruct(&row))
+			rows = append(rows, row)
+		}
+	}
+	require.NoError(t, res.Err())
+
+	comment := "comment"
+	require.Equal(t, []scanStructRow{
+		{
+			scanStructBase: scanStructBase{ID: 1},
+			Title:          "first",
+			Comment:        &comment,
+			Score:          10,
+		},
+		{
+			scanStructBase: scanStructBase{ID: 2},
+			Title:          "second",
+		},
+	}, rows)
+}
+
+func TestScanStructSelectedColumns(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	res := newScanStructResult(a)
+	require.True(t, res.NextResultSet(context.Background(), "title", "id"))
+	require.True(t, res.NextRow())
+
+	var row struct {
+		ID    uint64
+		Title string
+	}
+	require.NoError(t, res.ScanStruct(&row,
+		options.WithScanStructTagName("json"),
+	), "fields are mapped by names without tags")
+	require.Equal(t, uint64(1), row.ID)
+	require.Equal(t, "first", row.Title)
+}
+
+func TestScanStructErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		dst  interface{}
+		opts []options.ScanStructOption
+		err  bool
+	}{
+		{
+			name: "MissingFieldInStruct",
+			dst: &struct {
+				ID    uint64 `sql:"id"`
+				Title string `sql:"title"`
+			}{},
+			err: true,
+		},
+		{
+			name: "AllowMissingFieldInStruct",
+			dst: &struct {
+				ID    uint64 `sql:"id"`
+				Title string `sql:"title"`
+			}{},
+			opts: []options.ScanStructOption{
+				options.WithScanStructAllowMissingFieldsInStruct(),
+			},
+		},
+		{
+			name: "MissingColumnFromSelect",
+			dst: &struct {
+				scanStructRow
+				Extra string `sql:"extra"`
+			}{},
+			err: true,
+		},
+		{
+			name: "AllowMissingColumnFromSelect",
+			dst: &struct {
+				scanStructRow
+				Extra string `sql:"extra"`
+			}{},
+			opts: []options.ScanStructOption{
+				options.WithScanStructAllowMissingColumnsFromSelect(),
+			},
+		},
+		{
+			name: "NotPointer",
+			dst:  scanStructRow{},
+			err:  true,
+		},
+		{
+			name: "WrongType",
+			dst: &struct {
+				scanStructBase
+				Title   int64   `sql:"title"`
+				Comment *string `sql:"comment"`
+				Score   int32   `sql:"score"`
+			}{},
+			err: true,
+		},
+		{
+			name: "AmbiguousCaseInsensitiveField",
+			dst: &struct {
+				scanStructBase
+				Title      string  `sql:"Title"`
+				UpperTitle string  `sql:"TITLE"`
+				Comment    *string `sql:"comment"`
+				Score      int32   `sql:"score"`
+			}{},
+			err: true,
+		},
+		{
+			name: "CaseInsensitiveField",
+			dst: &struct {
+				scanStructBase
+				Title   string  `sql:"Title"`
+				Comment *string `sql:"comment"`
+				Score   int32   `sql:"score"`
+			}{},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			a := allocator.New()
+			defer a.Free()
+
+			res := newScanStructResult(a)
+			require.True(t, res.NextResultSet(context.Background()))
+			require.True(t, res.NextRow())
+			err := res.ScanStruct(test.dst, test.opts...)
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ import (
 type session struct {
 	id           string
 	tableService Ydb_Table_V1.TableServiceClient
+	cc           grpc.ClientConnInterface // creates sessions of resumed streams
 	config       *config.Config
 
 	status    table.SessionStatus
@@ -53,6 +54,8 @@ type session struct {
 
 	onClose   []func(s *session)
 	closeOnce sync.Once
+
+	statements *statementsCache // nil if prepared statements cache disabled
 }
 
 func (s *session) LastUsage() time.Time {
@@ -141,11 +144,16 @@ func newSession(ctx context.Context, cc grpc.ClientConnInterface, config *config
 
 	s = &session{
 		id:     result.GetSessionId(),
+		cc:     cc,
 		config: config,
 		status: table.SessionReady,
 	}
 	s.lastUsage.Store(time.Now().Unix())
 
+	if size := config.PreparedStatementsCacheSize(); size > 0 {
+		s.statements = newStatementsCache(size)
+	}
+
 	s.tableService = Ydb_Table_V1.NewTableServiceClient(
 		conn.WithBeforeFunc(
 			conn.WithContextModifier(cc, func(ctx context.Context) context.Context {
@@ -650,9 +658,10 @@ func (s *session) Execute(
 	txr table.Transaction, r result.Result, err error,
 ) {
 	var (
-		a       = allocator.New()
-		q       = queryFromText(query)
-		request = options.ExecuteDataQueryDesc{
+		a        = allocator.New()
+		q        = queryFromText(query)
+		prepared = false
+		request  = options.ExecuteDataQueryDesc{
 			ExecuteDataQueryRequest: a.TableExecuteDataQueryRequest(),
 			IgnoreTruncated:         s.config.IgnoreTruncated(),
 		}
@@ -660,12 +669,18 @@ func (s *session) Execute(
 	)
 	defer a.Free()
 
+	if s.statements != nil {
+		var id string
+		if id, prepared = s.statements.get(query); prepared {
+			q = queryPrepared(id, query)
+		}
+		trace.TableOnSessionQueryCache(s.config.Trace(), &ctx, s, query, prepared)
+	}
+
 	request.SessionId = s.id
 	request.TxControl = txControl.Desc()
 	request.Parameters = params.Params().ToYDB(a)
 	request.Query = q.toYDB(a)
-	request.QueryCachePolicy = a.TableQueryCachePolicy()
-	request.QueryCachePolicy.KeepInCache = len(params.Params()) > 0
 	request.OperationParams = operation.Params(ctx,
 		s.config.OperationTimeout(),
 		s.config.OperationCancelAfter(),
@@ -677,20 +692,38 @@ func (s *session) Execute(
 			callOptions = append(callOptions, opt.ApplyExecuteDataQueryOption(&request, a)...)
 		}
 	}
+	if request.QueryCachePolicy == nil {
+		// query cache policy is not defined by options
+		request.QueryCachePolicy = a.TableQueryCachePolicy()
+		request.QueryCachePolicy.KeepInCache = len(params.Params()) > 0 || s.statements != nil
+	}
 
 	onDone := trace.TableOnSessionQueryExecute(
 		s.config.Trace(), &ctx, s, q, params,
 		request.QueryCachePolicy.GetKeepInCache(),
 	)
 	defer func() {
-		onDone(txr, false, r, err)
+		onDone(txr, prepared, r, err)
 	}()
 
 	result, err := s.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
+	if err != nil && prepared && isPreparedQueryNotFound(err) {
+		// prepared query was evicted on server side, query was not executed
+		s.statements.remove(query)
+		prepared = false
+		request.Query = queryFromText(query).toYDB(a)
+		result, err = s.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
+	}
 	if err != nil {
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
+	if s.statements != nil && !prepared && request.QueryCachePolicy.GetKeepInCache() {
+		if id := result.GetQueryMeta().GetId(); id != "" {
+			s.statements.put(query, id)
+		}
+	}
+
 	return s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated)
 }
 
@@ -913,6 +946,47 @@ func (s *session) StreamReadTable(
 	path string,
 	opts ...options.ReadTableOption,
 ) (_ result.StreamResult, err error) {
+	var (
+		resumable  = isResumableReadTable(opts)
+		primaryKey []string
+	)
+	if resumable {
+		desc, err := s.DescribeTable(ctx, path)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		primaryKey = desc.PrimaryKey
+		// read resumes after primary key of the last delivered row
+		opts = readTableWithPrimaryKey(primaryKey, opts)
+	}
+
+	recv, onClose, err := s.streamReadTable(ctx, path, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if resumable {
+		recv, onClose = s.newResumableStream(ctx, recv, onClose, resumeReadTable(path, primaryKey, opts))
+	}
+
+	return scanner.NewStream(
+		recv,
+		onClose,
+		scanner.WithIgnoreTruncated(true), // stream read table always returns truncated flag on last result set
+	), nil
+}
+
+// streamReadTable opens stream of StreamReadTable call and returns function for receive result sets
+// from stream and function which must be called on close of stream
+func (s *session) streamReadTable(
+	ctx context.Context,
+	path string,
+	opts ...options.ReadTableOption,
+) (
+	recv streamRecv,
+	onClose func(err error) error,
+	err error,
+) {
 	var (
 		onIntermediate = trace.TableOnSessionQueryStreamRead(s.config.Trace(), &ctx, s)
 		request        = Ydb_Table.ReadTableRequest{
@@ -941,15 +1015,10 @@ func (s *session) StreamReadTable(
 
 	if err != nil {
 		cancel()
-		return nil, xerrors.WithStackTrace(err)
+		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	return scanner.NewStream(
-		func(ctx context.Context) (
-			set *Ydb.ResultSet,
-			stats *Ydb_TableStats.QueryStats,
-			err error,
-		) {
+	return func(ctx context.Context) (set *Ydb.ResultSet, stats *Ydb_TableStats.QueryStats, err error) {
 			defer func() {
 				onIntermediate(xerrors.HideEOF(err))
 			}()
@@ -971,8 +1040,7 @@ func (s *session) StreamReadTable(
 			onIntermediate(xerrors.HideEOF(err))(xerrors.HideEOF(err))
 			return err
 		},
-		scanner.WithIgnoreTruncated(true), // stream read table always returns truncated flag on last result set
-	), nil
+		nil
 }
 
 func (s *session) ReadRows(
@@ -1031,6 +1099,35 @@ func (s *session) StreamExecuteScanQuery(
 	params *table.QueryParameters,
 	opts ...options.ExecuteScanQueryOption,
 ) (_ result.StreamResult, err error) {
+	recv, onClose, err := s.streamExecuteScanQuery(ctx, query, params, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if isResumableScanQuery(opts) {
+		recv, onClose = s.newResumableStream(ctx, recv, onClose, resumeExecuteScanQuery(query, params, opts))
+	}
+
+	return scanner.NewStream(
+		recv,
+		onClose,
+		scanner.WithIgnoreTruncated(s.config.IgnoreTruncated()),
+		scanner.WithMarkTruncatedAsRetryable(),
+	), nil
+}
+
+// streamExecuteScanQuery opens stream of StreamExecuteScanQuery call and returns function for receive
+// result sets from stream and function which must be called on close of stream
+func (s *session) streamExecuteScanQuery(
+	ctx context.Context,
+	query string,
+	params *table.QueryParameters,
+	opts ...options.ExecuteScanQueryOption,
+) (
+	recv streamRecv,
+	onClose func(err error) error,
+	err error,
+) {
 	var (
 		a              = allocator.New()
 		q              = queryFromText(query)
@@ -1068,11 +1165,10 @@ func (s *session) StreamExecuteScanQuery(
 
 	if err != nil {
 		cancel()
-		return nil, xerrors.WithStackTrace(err)
+		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	return scanner.NewStream(
-		func(ctx context.Context) (
+	return func(ctx context.Context) (
 			set *Ydb.ResultSet,
 			stats *Ydb_TableStats.QueryStats,
 			err error,
@@ -1098,9 +1194,7 @@ func (s *session) StreamExecuteScanQuery(
 			onIntermediate(xerrors.HideEOF(err))(xerrors.HideEOF(err))
 			return err
 		},
-		scanner.WithIgnoreTruncated(s.config.IgnoreTruncated()),
-		scanner.WithMarkTruncatedAsRetryable(),
-	), nil
+		nil
 }
 
 // BulkUpsert uploads given list of ydb struct values to the table.
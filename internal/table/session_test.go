@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
@@ -21,10 +22,13 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestSessionKeepAlive(t *testing.T) {
@@ -624,3 +628,108 @@ func TestDescribeTableRegression(t *testing.T) {
 
 	assert.Equal(t, exp, act)
 }
+
+func TestSessionPreparedStatementsCache(t *testing.T) {
+	var (
+		ctx      = xtest.Context(t)
+		requests []*Ydb_Table.Query
+		evicted  = false
+		hits     []bool
+
+		tableNotFound       = false
+		keepInCacheDisabled = false
+	)
+	b := testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableExecuteDataQuery: func(request interface{}) (proto.Message, error) {
+					r, ok := request.(*Ydb_Table.ExecuteDataQueryRequest)
+					if !ok {
+						t.Fatalf("unexpected request type: %T", request)
+					}
+					requests = append(requests, proto.Clone(r.GetQuery()).(*Ydb_Table.Query))
+					if id := r.GetQuery().GetId(); id != "" {
+						switch {
+						case tableNotFound:
+							return nil, xerrors.Operation(
+								xerrors.WithStatusCode(Ydb.StatusIds_NOT_FOUND),
+								xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+									Message: "Cannot find table 'db.[series]'",
+								}}),
+							)
+						case evicted:
+							return nil, xerrors.Operation(
+								xerrors.WithStatusCode(Ydb.StatusIds_NOT_FOUND),
+								xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+									Message:   "Query not found: " + id,
+									IssueCode: issueCodePreparedQueryNotFound,
+								}}),
+							)
+						}
+						return &Ydb_Table.ExecuteQueryResult{}, nil
+					}
+					require.Equal(t, !keepInCacheDisabled, r.GetQueryCachePolicy().GetKeepInCache())
+					return &Ydb_Table.ExecuteQueryResult{
+						QueryMeta: &Ydb_Table.QueryMeta{
+							Id: fmt.Sprintf("id-%d", len(requests)),
+						},
+					}, nil
+				},
+			},
+		),
+	)
+	s := &session{
+		tableService: Ydb_Table_V1.NewTableServiceClient(b),
+		config: config.New(
+			config.WithPreparedStatementsCacheSize(1),
+			config.WithTrace(&trace.Table{
+				OnSessionQueryCache: func(info trace.TableSessionQueryCacheInfo) {
+					hits = append(hits, info.Hit)
+				},
+			}),
+		),
+		statements: newStatementsCache(1),
+	}
+
+	execute := func(query string) {
+		_, _, err := s.Execute(ctx, table.DefaultTxControl(), query, nil)
+		require.NoError(t, err)
+	}
+
+	execute("SELECT 1")
+	execute("SELECT 1")
+	require.Equal(t, "id-1", requests[1].GetId())
+
+	// least recently used query evicts
+	execute("SELECT 2")
+	execute("SELECT 1")
+	require.Equal(t, "SELECT 1", requests[3].GetYqlText())
+
+	// query re-executes by text if prepared query not found on server side
+	evicted = true
+	execute("SELECT 1")
+	require.Equal(t, "id-4", requests[4].GetId())
+	require.Equal(t, "SELECT 1", requests[5].GetYqlText())
+	evicted = false
+	execute("SELECT 1")
+	require.Equal(t, "id-6", requests[6].GetId())
+
+	require.Equal(t, []bool{false, true, false, false, true, true}, hits)
+
+	// other NOT_FOUND errors returns without re-execution by text
+	tableNotFound = true
+	_, _, err := s.Execute(ctx, table.DefaultTxControl(), "SELECT 1", nil)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+	require.Len(t, requests, 8)
+	require.Equal(t, "id-6", requests[7].GetId())
+
+	// explicit keep-in-cache choice of caller is not overridden and query is not cached without it
+	tableNotFound = false
+	keepInCacheDisabled = true
+	for i := 0; i < 2; i++ {
+		_, _, err = s.Execute(ctx, table.DefaultTxControl(), "SELECT 3", nil, options.WithKeepInCache(false))
+		require.NoError(t, err)
+	}
+	require.Equal(t, "SELECT 3", requests[8].GetYqlText())
+	require.Equal(t, "SELECT 3", requests[9].GetYqlText())
+}
@@ -0,0 +1,71 @@
+package table
+
+import (
+	"container/list"
+	"sync"
+)
+
+// statementsCache is an LRU cache of prepared queries ids keyed by query text
+type statementsCache struct {
+	mu    sync.Mutex
+	size  int
+	items map[string]*list.Element // query text to element of lru
+	lru   *list.List               // list<*statementsCacheItem>, most recently used at front
+}
+
+type statementsCacheItem struct {
+	query string
+	id    string
+}
+
+func newStatementsCache(size int) *statementsCache {
+	return &statementsCache{
+		size:  size,
+		items: make(map[string]*list.Element, size),
+		lru:   list.New(),
+	}
+}
+
+// get returns id of prepared query and marks it as most recently used
+func (c *statementsCache) get(query string) (id string, has bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, has := c.items[query]
+	if !has {
+		return "", false
+	}
+	c.lru.MoveToFront(el)
+	return el.Value.(*statementsCacheItem).id, true
+}
+
+// put stores id of prepared query and evicts least recently used query if cache is full
+func (c *statementsCache) put(query, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, has := c.items[query]; has {
+		el.Value.(*statementsCacheItem).id = id
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.items[query] = c.lru.PushFront(&statementsCacheItem{
+		query: query,
+		id:    id,
+	})
+	for c.lru.Len() > c.size {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.items, el.Value.(*statementsCacheItem).query)
+	}
+}
+
+func (c *statementsCache) remove(query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, has := c.items[query]; has {
+		c.lru.Remove(el)
+		delete(c.items, query)
+	}
+}
@@ -1,14 +1,19 @@
 package topic
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"time"
 
+	"github.com/jonboulle/clockwork"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 const (
@@ -18,6 +23,8 @@ const (
 type RetrySettings struct {
 	StartTimeout time.Duration // Full retry timeout
 	CheckError   PublicCheckErrorRetryFunction
+	Budget       budget.Budget // Budget of reconnection attempts, nil means unlimited
+	Trace        *trace.Retry  // Trace of budget exhaustion, nil means no trace
 }
 
 type PublicCheckErrorRetryFunction func(errInfo PublicCheckErrorRetryArgs) PublicCheckRetryResult
@@ -47,6 +54,40 @@ func CheckResetReconnectionCounters(lastTry, now time.Time, connectionTimeout ti
 	return now.Sub(lastTry) > connectionTimeout*resetAttemptEmpiricalCoefficient
 }
 
+// WaitRetryBudget waits until budget of settings allows reconnection attempt.
+// Attempts of budget checks with delay between them.
+// Exhaustion of budget reports once per wait with attempts and reason of reconnection
+func WaitRetryBudget(
+	ctx context.Context,
+	clock clockwork.Clock,
+	settings RetrySettings,
+	delay time.Duration,
+	attempts int,
+	reason error,
+) error {
+	if settings.Budget == nil {
+		return nil
+	}
+	for exhausted := false; ; exhausted = true {
+		err := settings.Budget.Acquire(ctx)
+		if err == nil {
+			return nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return xerrors.WithStackTrace(ctxErr)
+		}
+		if !exhausted && settings.Trace != nil {
+			trace.RetryOnBudgetExhausted(settings.Trace, &ctx, "", attempts, reason)
+		}
+		select {
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-clock.After(delay):
+			// pass
+		}
+	}
+}
+
 func CheckRetryMode(err error, settings RetrySettings, retriesDuration time.Duration) (
 	_ backoff.Backoff,
 	isRetriable bool,
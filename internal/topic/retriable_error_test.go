@@ -1,11 +1,15 @@
 package topic
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	grpcCodes "google.golang.org/grpc/codes"
@@ -13,6 +17,8 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestCheckRetryMode(t *testing.T) {
@@ -232,3 +238,67 @@ func TestCheckRetryMode(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitRetryBudget(t *testing.T) {
+	ctx := context.Background()
+	clock := clockwork.NewFakeClock()
+
+	t.Run("NoBudget", func(t *testing.T) {
+		require.NoError(t, WaitRetryBudget(ctx, clock, RetrySettings{}, time.Second, 0, nil))
+	})
+	t.Run("WaitQuota", func(t *testing.T) {
+		reason := errors.New("test")
+		exhausted := make(chan trace.RetryBudgetExhaustedInfo, 2)
+		settings := RetrySettings{
+			Budget: &testBudget{quota: 0},
+			Trace: &trace.Retry{
+				OnBudgetExhausted: func(info trace.RetryBudgetExhaustedInfo) {
+					exhausted <- info
+				},
+			},
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- WaitRetryBudget(ctx, clock, settings, time.Second, 3, reason)
+		}()
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+		clock.BlockUntil(1)
+		settings.Budget.(*testBudget).add(1)
+		clock.Advance(time.Second)
+		require.NoError(t, <-done)
+
+		// exhaustion reported once per wait
+		require.Len(t, exhausted, 1)
+		info := <-exhausted
+		require.Equal(t, 3, info.Attempts)
+		require.ErrorIs(t, info.Error, reason)
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := WaitRetryBudget(ctx, clock, RetrySettings{Budget: &testBudget{}}, time.Second, 0, nil)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+type testBudget struct {
+	mu    sync.Mutex
+	quota int
+}
+
+func (b *testBudget) add(quota int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.quota += quota
+}
+
+func (b *testBudget) Acquire(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.quota == 0 {
+		return budget.ErrNoQuota
+	}
+	b.quota--
+	return nil
+}
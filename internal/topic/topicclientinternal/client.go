@@ -251,3 +251,30 @@ func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption
 	}
 	return topicwriter.NewWriter(writer), nil
 }
+
+// StartMultiWriter create writers to all active partitions of topic and returns MultiWriter over them
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func (c *Client) StartMultiWriter(
+	ctx context.Context,
+	topicPath string,
+	opts ...topicoptions.WriterOption,
+) (*topicwriter.MultiWriter, error) {
+	desc, err := c.Describe(ctx, topicPath)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make([]int64, 0, len(desc.Partitions))
+	for i := range desc.Partitions {
+		if desc.Partitions[i].Active {
+			partitions = append(partitions, desc.Partitions[i].PartitionID)
+		}
+	}
+
+	return topicwriter.NewMultiWriter(ctx, partitions, func(partitionID int64) (*topicwriter.Writer, error) {
+		return c.StartWriter(topicPath, append(opts[:len(opts):len(opts)], topicoptions.WithPartitionID(partitionID))...)
+	})
+}
@@ -4,6 +4,10 @@ import (
 	"compress/gzip"
 	"fmt"
 	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -22,6 +26,10 @@ func newDecoderMap() decoderMap {
 			rawtopiccommon.CodecGzip: func(input io.Reader) (io.Reader, error) {
 				return gzip.NewReader(input)
 			},
+			rawtopiccommon.CodecZstd: newZstdReader,
+			rawtopiccommon.CodecLz4: func(input io.Reader) (io.Reader, error) {
+				return lz4.NewReader(input), nil
+			},
 		},
 	}
 }
@@ -39,6 +47,46 @@ func (m *decoderMap) Decode(codec rawtopiccommon.Codec, input io.Reader) (io.Rea
 	))
 }
 
+// zstdDecoders is pool of zstd decoders, because of creation of decoder is expensive
+var zstdDecoders sync.Pool
+
+// zstdReader returns decoder to pool after read of all content
+type zstdReader struct {
+	decoder *zstd.Decoder
+	err     error
+}
+
+func newZstdReader(input io.Reader) (io.Reader, error) {
+	if decoder, ok := zstdDecoders.Get().(*zstd.Decoder); ok {
+		if err := decoder.Reset(input); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		return &zstdReader{decoder: decoder}, nil
+	}
+
+	// decoder with concurrency 1 decodes synchronously and needs no close
+	decoder, err := zstd.NewReader(input, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return &zstdReader{decoder: decoder}, nil
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.decoder == nil {
+		return 0, r.err
+	}
+
+	n, err := r.decoder.Read(p)
+	if err != nil {
+		r.err = err
+		_ = r.decoder.Reset(nil)
+		zstdDecoders.Put(r.decoder)
+		r.decoder = nil
+	}
+	return n, err
+}
+
 // PublicCreateDecoderFunc
 //
 // # Experimental
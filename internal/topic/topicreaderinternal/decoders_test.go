@@ -0,0 +1,62 @@
+package topicreaderinternal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+)
+
+func TestDecoderMap(t *testing.T) {
+	encoders := map[rawtopiccommon.Codec]func(output io.Writer) (io.WriteCloser, error){
+		rawtopiccommon.CodecGzip: func(output io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(output), nil
+		},
+		rawtopiccommon.CodecZstd: func(output io.Writer) (io.WriteCloser, error) {
+			return zstd.NewWriter(output)
+		},
+		rawtopiccommon.CodecLz4: func(output io.Writer) (io.WriteCloser, error) {
+			return lz4.NewWriter(output), nil
+		},
+	}
+
+	m := newDecoderMap()
+	content := []byte(strings.Repeat("test", 100))
+	for codec, encoder := range encoders {
+		// twice for check reuse of decoders
+		for i := 0; i < 2; i++ {
+			testDecodeRoundTrip(t, m, codec, encoder, content)
+		}
+	}
+
+	_, err := m.Decode(rawtopiccommon.CodecLzop, &bytes.Buffer{})
+	require.ErrorIs(t, err, PublicErrUnexpectedCodec)
+}
+
+func testDecodeRoundTrip(
+	t *testing.T,
+	m decoderMap,
+	codec rawtopiccommon.Codec,
+	encoder func(output io.Writer) (io.WriteCloser, error),
+	content []byte,
+) {
+	buf := &bytes.Buffer{}
+	writer, err := encoder(buf)
+	require.NoError(t, err)
+	_, err = writer.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	reader, err := m.Decode(codec, buf)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, content, decoded)
+}
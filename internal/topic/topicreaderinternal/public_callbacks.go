@@ -40,3 +40,57 @@ type PublicGetPartitionStartOffsetFunc func(
 	ctx context.Context,
 	req PublicGetPartitionStartOffsetRequest,
 ) (res PublicGetPartitionStartOffsetResponse, err error)
+
+// PublicOnPartitionStartRequest
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type PublicOnPartitionStartRequest struct {
+	Topic           string
+	PartitionID     int64
+	CommittedOffset int64
+}
+
+// PublicOnPartitionStartFunc called when server starts read of partition, before start confirmation sent.
+// ctx is context of partition session, it cancels when partition session stops.
+// If callback returns error - reader closes with the error.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type PublicOnPartitionStartFunc func(
+	ctx context.Context,
+	req PublicOnPartitionStartRequest,
+) error
+
+// PublicOnPartitionStopRequest
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type PublicOnPartitionStopRequest struct {
+	Topic           string
+	PartitionID     int64
+	CommittedOffset int64
+
+	// Graceful is true if server waits stop confirmation from reader.
+	// Partition messages may be committed until callback returns.
+	// Graceful is false if partition session already stopped without confirmation.
+	Graceful bool
+}
+
+// PublicOnPartitionStopFunc called when server stops read of partition. All messages of partition
+// which received before stop are already returned to reader.
+// On graceful stop callback called before stop confirmation sent.
+// ctx is context of partition session, it cancels after callback returns on graceful stop
+// and already canceled on not graceful stop.
+// If callback returns error - reader closes with the error.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type PublicOnPartitionStopFunc func(
+	ctx context.Context,
+	req PublicOnPartitionStopRequest,
+) error
@@ -93,6 +93,13 @@ func NewReader(
 	cfg := convertNewParamsToStreamConfig(consumer, readSelectors, opts...)
 	readerID := nextReaderID()
 
+	if cfg.RetrySettings.Budget == nil {
+		cfg.RetrySettings.Budget = cfg.RetryBudget()
+	}
+	if cfg.RetrySettings.Trace == nil {
+		cfg.RetrySettings.Trace = cfg.RetryTrace()
+	}
+
 	readerConnector := func(ctx context.Context) (batchedStreamReader, error) {
 		stream, err := connector(ctx)
 		if err != nil {
@@ -67,6 +67,8 @@ type topicStreamReaderConfig struct {
 	ReadSelectors                   []*PublicReadSelector
 	Trace                           *trace.Topic
 	GetPartitionStartOffsetCallback PublicGetPartitionStartOffsetFunc
+	OnPartitionStart                PublicOnPartitionStartFunc
+	OnPartitionStop                 PublicOnPartitionStopFunc
 	CommitMode                      PublicCommitMode
 	Decoders                        decoderMap
 }
@@ -297,6 +299,18 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 		onDone(err)
 	}()
 
+	if r.cfg.OnPartitionStop != nil {
+		err = r.cfg.OnPartitionStop(session.Context(), PublicOnPartitionStopRequest{
+			Topic:           session.Topic,
+			PartitionID:     session.PartitionID,
+			CommittedOffset: msg.CommittedOffset.ToInt64(),
+			Graceful:        msg.Graceful,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	if msg.Graceful {
 		session.Close()
 		resp := &rawtopicreader.StopPartitionSessionResponse{
@@ -765,6 +779,17 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequestFromBuffer(
 		onDone(forceOffset, commitOffset, err)
 	}()
 
+	if r.cfg.OnPartitionStart != nil {
+		err = r.cfg.OnPartitionStart(session.Context(), PublicOnPartitionStartRequest{
+			Topic:           session.Topic,
+			PartitionID:     session.PartitionID,
+			CommittedOffset: session.committedOffset().ToInt64(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	if r.cfg.GetPartitionStartOffsetCallback != nil {
 		req := PublicGetPartitionStartOffsetRequest{
 			Topic:       session.Topic,
@@ -436,6 +436,91 @@ func TestStreamReaderImpl_OnPartitionCloseHandle(t *testing.T) {
 	})
 }
 
+func TestStreamReaderImpl_PartitionCallbacks(t *testing.T) {
+	xtest.TestManyTimesWithName(t, "OnPartitionStart", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+
+		readMessagesCtx, readMessagesCtxCancel := xcontext.WithCancel(context.Background())
+		callbackCalled := make(empty.Chan)
+
+		e.reader.cfg.OnPartitionStart = func(ctx context.Context, req PublicOnPartitionStartRequest) error {
+			require.NoError(t, ctx.Err())
+			require.Equal(t, PublicOnPartitionStartRequest{
+				Topic:           "/test2",
+				PartitionID:     7,
+				CommittedOffset: 10,
+			}, req)
+			close(callbackCalled)
+			readMessagesCtxCancel()
+			return nil
+		}
+
+		e.Start()
+
+		startPartitionResponseSent := make(empty.Chan)
+		e.stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg rawtopicreader.ClientMessage) error {
+			require.Equal(t, partitionSessionID(100), msg.(*rawtopicreader.StartPartitionSessionResponse).PartitionSessionID)
+			xtest.WaitChannelClosed(t, callbackCalled)
+			close(startPartitionResponseSent)
+			return nil
+		})
+
+		e.SendFromServer(&rawtopicreader.StartPartitionSessionRequest{
+			PartitionSession: rawtopicreader.PartitionSession{
+				PartitionSessionID: 100,
+				Path:               "/test2",
+				PartitionID:        7,
+			},
+			CommittedOffset: 10,
+		})
+
+		_, err := e.reader.ReadMessageBatch(readMessagesCtx, newReadMessageBatchOptions())
+		require.Error(t, err)
+		xtest.WaitChannelClosed(t, startPartitionResponseSent)
+	})
+	xtest.TestManyTimesWithName(t, "OnPartitionStopGraceful", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+
+		readMessagesCtx, readMessagesCtxCancel := xcontext.WithCancel(context.Background())
+		callbackCalled := make(empty.Chan)
+
+		e.reader.cfg.OnPartitionStop = func(ctx context.Context, req PublicOnPartitionStopRequest) error {
+			// partition session is alive until stop confirmation
+			require.NoError(t, ctx.Err())
+			require.Equal(t, PublicOnPartitionStopRequest{
+				Topic:           e.partitionSession.Topic,
+				PartitionID:     e.partitionSession.PartitionID,
+				CommittedOffset: 222,
+				Graceful:        true,
+			}, req)
+			close(callbackCalled)
+			readMessagesCtxCancel()
+			return nil
+		}
+
+		e.Start()
+
+		stopPartitionResponseSent := make(empty.Chan)
+		e.stream.EXPECT().Send(&rawtopicreader.StopPartitionSessionResponse{
+			PartitionSessionID: e.partitionSessionID,
+		}).Return(nil).Do(func(_ interface{}) {
+			xtest.WaitChannelClosed(t, callbackCalled)
+			close(stopPartitionResponseSent)
+		})
+
+		e.SendFromServer(&rawtopicreader.StopPartitionSessionRequest{
+			PartitionSessionID: e.partitionSessionID,
+			Graceful:           true,
+			CommittedOffset:    rawtopicreader.NewOffset(222),
+		})
+
+		_, err := e.reader.ReadMessageBatch(readMessagesCtx, newReadMessageBatchOptions())
+		require.Error(t, err)
+		xtest.WaitChannelClosed(t, stopPartitionResponseSent)
+		require.Error(t, e.partitionSession.Context().Err())
+	})
+}
+
 func TestTopicStreamReaderImpl_ReadMessages(t *testing.T) {
 	t.Run("BufferSize", func(t *testing.T) {
 		waitChangeRestBufferSizeBytes := func(r *topicStreamReaderImpl, old int64) {
@@ -206,6 +206,10 @@ func (r *readerReconnector) reconnectionLoop(ctx context.Context) {
 				case <-r.clock.After(delay):
 					// pass
 				}
+
+				if err := topic.WaitRetryBudget(ctx, r.clock, r.retrySettings, delay, attempt, request.reason); err != nil {
+					return
+				}
 			}
 		}
 
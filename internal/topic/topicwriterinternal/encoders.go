@@ -6,6 +6,9 @@ import (
 	"io"
 	"sync"
 
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
@@ -30,6 +33,10 @@ func NewEncoderMap() *EncoderMap {
 			rawtopiccommon.CodecGzip: func(writer io.Writer) (io.WriteCloser, error) {
 				return gzip.NewWriter(writer), nil
 			},
+			rawtopiccommon.CodecZstd: newZstdWriter,
+			rawtopiccommon.CodecLz4: func(writer io.Writer) (io.WriteCloser, error) {
+				return lz4.NewWriter(writer), nil
+			},
 		},
 	}
 }
@@ -68,6 +75,45 @@ func (nopWriteCloser) Close() error {
 	return nil
 }
 
+// zstdEncoders is pool of zstd encoders, because of creation of encoder is expensive
+var zstdEncoders sync.Pool
+
+// zstdWriteCloser returns encoder to pool on close
+type zstdWriteCloser struct {
+	encoder *zstd.Encoder
+}
+
+func newZstdWriter(writer io.Writer) (io.WriteCloser, error) {
+	if encoder, ok := zstdEncoders.Get().(*zstd.Encoder); ok {
+		encoder.Reset(writer)
+		return &zstdWriteCloser{encoder: encoder}, nil
+	}
+
+	encoder, err := zstd.NewWriter(writer, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return &zstdWriteCloser{encoder: encoder}, nil
+}
+
+func (w *zstdWriteCloser) Write(p []byte) (int, error) {
+	return w.encoder.Write(p)
+}
+
+func (w *zstdWriteCloser) Close() error {
+	if w.encoder == nil {
+		return nil
+	}
+
+	encoder := w.encoder
+	w.encoder = nil
+
+	err := encoder.Close()
+	encoder.Reset(nil)
+	zstdEncoders.Put(encoder)
+	return err
+}
+
 // EncoderSelector not thread safe
 type EncoderSelector struct {
 	m *EncoderMap
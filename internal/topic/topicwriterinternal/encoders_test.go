@@ -2,9 +2,13 @@ package topicwriterinternal
 
 import (
 	"bytes"
+	"compress/gzip"
+	"io"
 	"strings"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
@@ -150,6 +154,35 @@ func TestEncoderSelector_CodecMeasure(t *testing.T) {
 	})
 }
 
+func TestEncoderSelector_AutoSelectZstd(t *testing.T) {
+	encoders := NewEncoderMap()
+
+	// zstd is not selected for topic without supported codecs list from server
+	require.NotContains(t, calculateAllowedCodecs(rawtopiccommon.CodecUNSPECIFIED, encoders, nil),
+		rawtopiccommon.CodecZstd,
+	)
+
+	// topic with zstd in supported codecs list
+	allowedCodecs := calculateAllowedCodecs(rawtopiccommon.CodecUNSPECIFIED, encoders, rawtopiccommon.SupportedCodecs{
+		rawtopiccommon.CodecRaw,
+		rawtopiccommon.CodecGzip,
+		rawtopiccommon.CodecZstd,
+	})
+	require.Contains(t, allowedCodecs, rawtopiccommon.CodecZstd)
+
+	s := NewEncoderSelector(encoders, allowedCodecs, 1, &trace.Topic{}, "", "")
+
+	var messages []messageWithDataContent
+	for i := 0; i < 10; i++ {
+		data := make([]byte, 100000)
+		messages = append(messages, newMessageDataWithContent(Message{Data: bytes.NewReader(data)}, encoders))
+	}
+
+	codec, err := s.measureCodecs(messages)
+	require.NoError(t, err)
+	require.Equal(t, rawtopiccommon.CodecZstd, codec)
+}
+
 func TestCompressMessages(t *testing.T) {
 	t.Run("NoMessages", func(t *testing.T) {
 		require.NoError(t, readInParallelWithCodec(nil, rawtopiccommon.CodecRaw, 1))
@@ -206,3 +239,44 @@ func TestCompressMessages(t *testing.T) {
 		require.Error(t, readInParallelWithCodec(messages, rawtopiccommon.CodecGzip, parallelCount))
 	})
 }
+
+func TestEncoderMap(t *testing.T) {
+	decoders := map[rawtopiccommon.Codec]func(input io.Reader) (io.Reader, error){
+		rawtopiccommon.CodecRaw: func(input io.Reader) (io.Reader, error) {
+			return input, nil
+		},
+		rawtopiccommon.CodecGzip: func(input io.Reader) (io.Reader, error) {
+			return gzip.NewReader(input)
+		},
+		rawtopiccommon.CodecZstd: func(input io.Reader) (io.Reader, error) {
+			return zstd.NewReader(input)
+		},
+		rawtopiccommon.CodecLz4: func(input io.Reader) (io.Reader, error) {
+			return lz4.NewReader(input), nil
+		},
+	}
+
+	m := NewEncoderMap()
+	require.Len(t, m.GetSupportedCodecs(), len(decoders))
+
+	content := []byte(strings.Repeat("test", 100))
+	for codec, decoder := range decoders {
+		require.True(t, m.IsSupported(codec))
+
+		// twice for check reuse of encoders
+		for i := 0; i < 2; i++ {
+			buf := &bytes.Buffer{}
+			writer, err := m.CreateLazyEncodeWriter(codec, buf)
+			require.NoError(t, err)
+			_, err = writer.Write(content)
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+
+			reader, err := decoder(buf)
+			require.NoError(t, err)
+			decoded, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.Equal(t, content, decoded)
+		}
+	}
+}
@@ -43,12 +43,14 @@ type messageQueue struct {
 
 	messagesByOrder map[int]messageWithDataContent
 	seqNoToOrderID  map[int64]int
+	ackResults      map[int]*PublicWriteResult // results for fill by acks, by order id of message
 }
 
 func newMessageQueue() messageQueue {
 	return messageQueue{
 		messagesByOrder: make(map[int]messageWithDataContent),
 		seqNoToOrderID:  make(map[int64]int),
+		ackResults:      make(map[int]*PublicWriteResult),
 		hasNewMessages:  make(empty.Chan, 1),
 		closedChan:      make(empty.Chan),
 		lastSeqNo:       -1,
@@ -56,7 +58,7 @@ func newMessageQueue() messageQueue {
 }
 
 func (q *messageQueue) AddMessages(messages []messageWithDataContent) error {
-	_, err := q.addMessages(messages, false)
+	_, err := q.addMessages(messages, false, false)
 	return err
 }
 
@@ -64,10 +66,19 @@ func (q *messageQueue) AddMessagesWithWaiter(messages []messageWithDataContent)
 	waiter MessageQueueAckWaiter,
 	err error,
 ) {
-	return q.addMessages(messages, true)
+	return q.addMessages(messages, true, false)
 }
 
-func (q *messageQueue) addMessages(messages []messageWithDataContent, needWaiter bool) (
+// AddMessagesWithResults add messages to queue and returns waiter, which results
+// are filled by acks from server
+func (q *messageQueue) AddMessagesWithResults(messages []messageWithDataContent) (
+	waiter MessageQueueAckWaiter,
+	err error,
+) {
+	return q.addMessages(messages, true, true)
+}
+
+func (q *messageQueue) addMessages(messages []messageWithDataContent, needWaiter, needResults bool) (
 	waiter MessageQueueAckWaiter,
 	err error,
 ) {
@@ -82,12 +93,19 @@ func (q *messageQueue) addMessages(messages []messageWithDataContent, needWaiter
 		return waiter, err
 	}
 
+	if needResults {
+		waiter.results = make([]PublicWriteResult, len(messages))
+	}
+
 	for i := range messages {
 		messageIndex := q.addMessageNeedLock(messages[i])
 
 		if needWaiter {
 			waiter.AddWaitIndex(messageIndex)
 		}
+		if needResults {
+			q.ackResults[messageIndex] = &waiter.results[i]
+		}
 	}
 
 	q.notifyNewMessages()
@@ -139,7 +157,25 @@ func (q *messageQueue) addMessageNeedLock(
 	return messageIndex
 }
 
-func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
+// Len returns count of messages which are not acked by server yet
+func (q *messageQueue) Len() (size int) {
+	q.m.WithRLock(func() {
+		size = len(q.messagesByOrder)
+	})
+	return size
+}
+
+// WaiterForAll returns waiter for all messages in queue, which are not acked by server yet
+func (q *messageQueue) WaiterForAll() (waiter MessageQueueAckWaiter) {
+	q.m.WithRLock(func() {
+		for messageIndex := range q.messagesByOrder {
+			waiter.AddWaitIndex(messageIndex)
+		}
+	})
+	return waiter
+}
+
+func (q *messageQueue) AcksReceived(partitionID int64, acks []rawtopicwriter.WriteAck) error {
 	ackReceivedCounter := 0
 	q.m.Lock()
 	defer func() {
@@ -151,7 +187,7 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	}()
 
 	for i := range acks {
-		if err := q.ackReceivedNeedLock(acks[i].SeqNo); err != nil {
+		if err := q.ackReceivedNeedLock(partitionID, &acks[i]); err != nil {
 			return err
 		}
 		ackReceivedCounter++
@@ -161,13 +197,18 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	return nil
 }
 
-func (q *messageQueue) ackReceivedNeedLock(seqNo int64) error {
-	orderID, ok := q.seqNoToOrderID[seqNo]
+func (q *messageQueue) ackReceivedNeedLock(partitionID int64, ack *rawtopicwriter.WriteAck) error {
+	orderID, ok := q.seqNoToOrderID[ack.SeqNo]
 	if !ok {
 		return xerrors.WithStackTrace(errAckUnexpectedMessage)
 	}
 
-	delete(q.seqNoToOrderID, seqNo)
+	if result, ok := q.ackResults[orderID]; ok {
+		result.fromAck(partitionID, ack)
+		delete(q.ackResults, orderID)
+	}
+
+	delete(q.seqNoToOrderID, ack.SeqNo)
 	delete(q.messagesByOrder, orderID)
 	return nil
 }
@@ -311,6 +352,7 @@ func (q *messageQueue) Wait(ctx context.Context, waiter MessageQueueAckWaiter) e
 
 type MessageQueueAckWaiter struct {
 	sequenseNumbers []int
+	results         []PublicWriteResult
 }
 
 func (m *MessageQueueAckWaiter) AddWaitIndex(index int) {
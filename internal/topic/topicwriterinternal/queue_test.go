@@ -16,6 +16,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xatomic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 )
 
 func TestMessageQueue_AddMessages(t *testing.T) {
@@ -412,7 +413,7 @@ func TestQueue_Ack(t *testing.T) {
 		q := newMessageQueue()
 		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 2, 5)))
 
-		require.NoError(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+		require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 2,
 			},
@@ -428,7 +429,7 @@ func TestQueue_Ack(t *testing.T) {
 		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1)))
 
 		// remove first with the seqno
-		require.Error(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+		require.Error(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 5,
 			},
@@ -452,7 +453,7 @@ func TestQueue_Ack(t *testing.T) {
 		err := q.AddMessages(newTestMessagesWithContent(1, 2, 3))
 		require.NoError(t, err)
 
-		err = q.AcksReceived([]rawtopicwriter.WriteAck{
+		err = q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 1,
 			},
@@ -465,7 +466,7 @@ func TestQueue_Ack(t *testing.T) {
 		require.Equal(t, 2, receivedCount)
 
 		// Double ack
-		err = q.AcksReceived([]rawtopicwriter.WriteAck{
+		err = q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 1,
 			},
@@ -477,6 +478,37 @@ func TestQueue_Ack(t *testing.T) {
 		require.Error(t, err)
 		require.Equal(t, 0, receivedCount)
 	})
+
+	t.Run("Results", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		q := newMessageQueue()
+
+		waiter, err := q.AddMessagesWithResults(newTestMessagesWithContent(1, 2))
+		require.NoError(t, err)
+
+		require.NoError(t, q.AcksReceived(3, []rawtopicwriter.WriteAck{
+			{
+				SeqNo: 1,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type:          rawtopicwriter.WriteStatusTypeWritten,
+					WrittenOffset: 10,
+				},
+			},
+			{
+				SeqNo: 2,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type:          rawtopicwriter.WriteStatusTypeSkipped,
+					SkippedReason: rawtopicwriter.WriteStatusSkipReasonAlreadyWritten,
+				},
+			},
+		}))
+		require.NoError(t, q.Wait(ctx, waiter))
+		require.Equal(t, []PublicWriteResult{
+			{SeqNo: 1, PartitionID: 3, Offset: 10},
+			{SeqNo: 2, PartitionID: 3, Skipped: true},
+		}, waiter.results)
+		require.Empty(t, q.ackResults)
+	})
 }
 
 func waitGetMessageStarted(q *messageQueue) {
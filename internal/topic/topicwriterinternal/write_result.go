@@ -0,0 +1,32 @@
+package topicwriterinternal
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+)
+
+// PublicWriteResult is result of write message to topic, received with ack from server
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type PublicWriteResult struct {
+	SeqNo       int64
+	PartitionID int64
+
+	// Offset of message in partition, defined for written messages only
+	Offset int64
+
+	// Skipped is true if server skipped message as duplicate, because of message with the SeqNo already written
+	Skipped bool
+}
+
+func (r *PublicWriteResult) fromAck(partitionID int64, ack *rawtopicwriter.WriteAck) {
+	r.SeqNo = ack.SeqNo
+	r.PartitionID = partitionID
+	switch ack.MessageWriteStatus.Type {
+	case rawtopicwriter.WriteStatusTypeWritten:
+		r.Offset = ack.MessageWriteStatus.WrittenOffset
+	case rawtopicwriter.WriteStatusTypeSkipped:
+		r.Skipped = true
+	}
+}
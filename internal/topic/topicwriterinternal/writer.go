@@ -48,6 +48,26 @@ func (w *Writer) Write(ctx context.Context, messages ...Message) error {
 	return w.streamWriter.Write(ctx, messages)
 }
 
+func (w *Writer) WriteWithAck(ctx context.Context, messages ...Message) ([]PublicWriteResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return w.streamWriter.WriteWithAck(ctx, messages)
+}
+
+func (w *Writer) Flush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return w.streamWriter.Flush(ctx)
+}
+
+func (w *Writer) WaitInit(ctx context.Context) (PublicWithOnWriterConnectedInfo, error) {
+	return w.streamWriter.WaitInit(ctx)
+}
+
 func (w *Writer) Close(ctx context.Context) error {
 	return w.streamWriter.Close(ctx)
 }
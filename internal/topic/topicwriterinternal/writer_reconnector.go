@@ -101,6 +101,13 @@ func newWriterReconnectorConfig(options ...PublicWriterOption) WriterReconnector
 		cfg.connectTimeout = cfg.Common.OperationTimeout()
 	}
 
+	if cfg.RetrySettings.Budget == nil {
+		cfg.RetrySettings.Budget = cfg.Common.RetryBudget()
+	}
+	if cfg.RetrySettings.Trace == nil {
+		cfg.RetrySettings.Trace = cfg.Common.RetryTrace()
+	}
+
 	if cfg.connectTimeout == 0 {
 		cfg.connectTimeout = value.InfiniteDuration
 	}
@@ -127,6 +134,7 @@ type WriterReconnector struct {
 	m           xsync.RWMutex
 	sessionID   string
 	lastSeqNo   int64
+	initInfo    PublicWithOnWriterConnectedInfo // info of first session, reconnects don't change it
 	encodersMap *EncoderMap
 }
 
@@ -202,19 +210,32 @@ func (w *WriterReconnector) start() {
 }
 
 func (w *WriterReconnector) Write(ctx context.Context, messages []Message) error {
+	_, err := w.write(ctx, messages, false)
+	return err
+}
+
+// WriteWithAck writes messages and waits acks from server for them, regardless of WaitServerAck option
+func (w *WriterReconnector) WriteWithAck(ctx context.Context, messages []Message) ([]PublicWriteResult, error) {
+	return w.write(ctx, messages, true)
+}
+
+func (w *WriterReconnector) write(ctx context.Context, messages []Message, withResults bool) (
+	[]PublicWriteResult,
+	error,
+) {
 	if err := w.background.CloseReason(); err != nil {
-		return xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
 	}
 	if ctx.Err() != nil {
-		return ctx.Err()
+		return nil, ctx.Err()
 	}
 	if len(messages) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	semaphoreWeight := int64(len(messages))
 	if semaphoreWeight > int64(w.cfg.MaxQueueLen) {
-		return xerrors.WithStackTrace(fmt.Errorf(
+		return nil, xerrors.WithStackTrace(fmt.Errorf(
 			"ydb: add more messages, then max queue limit. max queue: %v, try to add: %v: %w",
 			w.cfg.MaxQueueLen,
 			semaphoreWeight,
@@ -222,7 +243,7 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []Message) error
 		))
 	}
 	if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
-		return xerrors.WithStackTrace(
+		return nil, xerrors.WithStackTrace(
 			fmt.Errorf("ydb: add new messages exceed max queue size limit. Add count: %v, max size: %v: %w",
 				semaphoreWeight,
 				w.cfg.MaxQueueLen,
@@ -235,15 +256,15 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []Message) error
 
 	messagesSlice, err := w.createMessagesWithContent(messages)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = w.checkMessages(messagesSlice); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = w.waitFirstInitResponse(ctx); err != nil {
-		return err
+		return nil, err
 	}
 
 	var waiter MessageQueueAckWaiter
@@ -254,9 +275,12 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []Message) error
 			return
 		}
 
-		if w.cfg.WaitServerAck {
+		switch {
+		case withResults:
+			waiter, err = w.queue.AddMessagesWithResults(messagesSlice)
+		case w.cfg.WaitServerAck:
 			waiter, err = w.queue.AddMessagesWithWaiter(messagesSlice)
-		} else {
+		default:
 			err = w.queue.AddMessages(messagesSlice)
 		}
 		if err == nil {
@@ -265,14 +289,20 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []Message) error
 		}
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !w.cfg.WaitServerAck {
-		return nil
+	w.onQueueStateChange()
+
+	if !withResults && !w.cfg.WaitServerAck {
+		return nil, nil
+	}
+
+	if err = w.queue.Wait(ctx, waiter); err != nil {
+		return nil, err
 	}
 
-	return w.queue.Wait(ctx, waiter)
+	return waiter.results, nil
 }
 
 func (w *WriterReconnector) checkMessages(messages []messageWithDataContent) error {
@@ -380,6 +410,10 @@ func (w *WriterReconnector) connectionLoop(ctx context.Context) {
 				case <-w.clock.After(delay):
 					// pass
 				}
+
+				if err := topic.WaitRetryBudget(ctx, w.clock, w.retrySettings, delay, attempt, reconnectReason); err != nil {
+					return
+				}
 			} else {
 				_ = w.close(ctx, reconnectReason)
 				return
@@ -467,6 +501,14 @@ func (w *WriterReconnector) connectWithTimeout(streamLifetimeContext context.Con
 
 func (w *WriterReconnector) onAckReceived(count int) {
 	w.semaphore.Release(int64(count))
+	w.onQueueStateChange()
+}
+
+func (w *WriterReconnector) onQueueStateChange() {
+	if w.cfg.tracer.OnWriterQueueStateChange == nil {
+		return
+	}
+	trace.TopicOnWriterQueueStateChange(w.cfg.tracer, w.writerInstanceID, w.cfg.topic, w.queue.Len())
 }
 
 func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
@@ -478,6 +520,7 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 		}
 		w.sessionID = writerStream.SessionID
 
+		// initInfo is set once: WaitInit and init callback report first session only
 		if !w.firstConnectionHandled.CompareAndSwap(false, true) {
 			return
 		}
@@ -487,21 +530,26 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 		if w.cfg.AutoSetSeqNo {
 			w.lastSeqNo = writerStream.ReceivedLastSeqNum
 		}
+
+		w.initInfo = PublicWithOnWriterConnectedInfo{
+			LastSeqNo:        w.lastSeqNo,
+			SessionID:        w.sessionID,
+			PartitionID:      writerStream.PartitionID,
+			CodecsFromServer: createPublicCodecsFromRaw(writerStream.CodecsFromServer),
+		}
 	})
 
 	if isFirstInit {
-		w.onWriterInitCallbackHandler(writerStream)
+		w.onWriterInitCallbackHandler()
 	}
 }
 
-func (w *WriterReconnector) onWriterInitCallbackHandler(writerStream *SingleStreamWriter) {
+func (w *WriterReconnector) onWriterInitCallbackHandler() {
 	if w.cfg.OnWriterInitResponseCallback != nil {
-		info := PublicWithOnWriterConnectedInfo{
-			LastSeqNo:        w.lastSeqNo,
-			SessionID:        w.sessionID,
-			PartitionID:      writerStream.PartitionID,
-			CodecsFromServer: createPublicCodecsFromRaw(writerStream.CodecsFromServer),
-		}
+		var info PublicWithOnWriterConnectedInfo
+		w.m.WithRLock(func() {
+			info = w.initInfo
+		})
 
 		if err := w.cfg.OnWriterInitResponseCallback(info); err != nil {
 			_ = w.close(context.Background(), fmt.Errorf("OnWriterInitResponseCallback return error: %w", err))
@@ -528,6 +576,27 @@ func (w *WriterReconnector) waitFirstInitResponse(ctx context.Context) error {
 	}
 }
 
+// WaitInit waits first init response from server and returns info from it
+func (w *WriterReconnector) WaitInit(ctx context.Context) (info PublicWithOnWriterConnectedInfo, err error) {
+	if err = w.waitFirstInitResponse(ctx); err != nil {
+		return info, err
+	}
+
+	w.m.WithRLock(func() {
+		info = w.initInfo
+	})
+	return info, nil
+}
+
+// Flush waits acks from server for all messages, which were written before call
+func (w *WriterReconnector) Flush(ctx context.Context) error {
+	if err := w.background.CloseReason(); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
+	}
+
+	return w.queue.Wait(ctx, w.queue.WaiterForAll())
+}
+
 func (w *WriterReconnector) createWriterStreamConfig(stream RawTopicWriterStream) SingleStreamWriterConfig {
 	cfg := newSingleStreamWriterConfig(
 		w.cfg.WritersCommonConfig,
@@ -644,7 +713,7 @@ func calculateAllowedCodecs(forceCodec rawtopiccommon.Codec, encoderMap *Encoder
 
 	if len(serverCodecs) == 0 {
 		// fixed list for autoselect codec if empty server list for prevent unexpectedly add messages with new codec
-		// with sdk update
+		// with sdk update. Zstd is selected only if topic lists it or with explicit codec option
 		serverCodecs = rawtopiccommon.SupportedCodecs{rawtopiccommon.CodecRaw, rawtopiccommon.CodecGzip}
 	}
 
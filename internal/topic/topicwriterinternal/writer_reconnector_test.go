@@ -170,6 +170,104 @@ func TestWriterImpl_Write(t *testing.T) {
 			xtest.WaitChannelClosed(t, writeCompleted)
 		})
 	})
+	t.Run("WriteWithAck", func(t *testing.T) {
+		e := newTestEnv(t, nil)
+
+		messageTime := time.Date(2022, 9, 7, 11, 34, 0, 0, time.UTC)
+		messageData := []byte("123")
+
+		const seqNo = 31
+
+		writeMessageReceived := make(empty.Chan)
+		e.stream.EXPECT().Send(&rawtopicwriter.WriteRequest{
+			Messages: []rawtopicwriter.MessageData{
+				{
+					SeqNo:            seqNo,
+					CreatedAt:        messageTime,
+					UncompressedSize: int64(len(messageData)),
+					Partitioning:     rawtopicwriter.Partitioning{},
+					Data:             messageData,
+				},
+			},
+			Codec: rawtopiccommon.CodecRaw,
+		}).Do(func(_ interface{}) {
+			close(writeMessageReceived)
+		}).Return(nil)
+
+		writeCompleted := make(empty.Chan)
+		go func() {
+			defer close(writeCompleted)
+
+			results, err := e.writer.WriteWithAck(e.ctx, []Message{
+				{SeqNo: seqNo, CreatedAt: messageTime, Data: bytes.NewReader(messageData)},
+			})
+			require.NoError(t, err)
+			require.Equal(t, []PublicWriteResult{
+				{SeqNo: seqNo, PartitionID: e.partitionID, Offset: 4},
+			}, results)
+		}()
+
+		<-writeMessageReceived
+
+		e.sendFromServer(&rawtopicwriter.WriteResult{
+			Acks: []rawtopicwriter.WriteAck{
+				{
+					SeqNo: seqNo,
+					MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+						Type:          rawtopicwriter.WriteStatusTypeWritten,
+						WrittenOffset: 4,
+					},
+				},
+			},
+			PartitionID: e.partitionID,
+		})
+
+		xtest.WaitChannelClosed(t, writeCompleted)
+	})
+	t.Run("Flush", func(t *testing.T) {
+		e := newTestEnv(t, nil)
+
+		const seqNo = 31
+
+		writeMessageReceived := make(empty.Chan)
+		e.stream.EXPECT().Send(gomock.Any()).Do(func(_ interface{}) {
+			close(writeMessageReceived)
+		}).Return(nil)
+
+		require.NoError(t, e.writer.Write(e.ctx, []Message{{SeqNo: seqNo}}))
+
+		flushCompleted := make(empty.Chan)
+		go func() {
+			defer close(flushCompleted)
+
+			require.NoError(t, e.writer.Flush(e.ctx))
+		}()
+
+		<-writeMessageReceived
+
+		select {
+		case <-flushCompleted:
+			t.Fatal("flush must complete after receive ack only")
+		default:
+			// pass
+		}
+
+		e.sendFromServer(&rawtopicwriter.WriteResult{
+			Acks: []rawtopicwriter.WriteAck{
+				{
+					SeqNo: seqNo,
+					MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+						Type:          rawtopicwriter.WriteStatusTypeWritten,
+						WrittenOffset: 4,
+					},
+				},
+			},
+			PartitionID: e.partitionID,
+		})
+
+		xtest.WaitChannelClosed(t, flushCompleted)
+		require.NoError(t, e.writer.Flush(e.ctx))
+	})
 }
 
 func TestWriterImpl_WriteCodecs(t *testing.T) {
@@ -295,7 +393,7 @@ func TestWriterReconnector_Write_QueueLimit(t *testing.T) {
 
 		go func() {
 			waitStartQueueWait(1)
-			ackErr := w.queue.AcksReceived([]rawtopicwriter.WriteAck{
+			ackErr := w.queue.AcksReceived(0, []rawtopicwriter.WriteAck{
 				{
 					SeqNo: 1,
 				},
@@ -328,6 +426,34 @@ func TestWriterImpl_InitSession(t *testing.T) {
 	require.Equal(t, sessionID, w.sessionID)
 	require.Equal(t, lastSeqNo, w.lastSeqNo)
 	require.True(t, isClosed(w.firstInitResponseProcessedChan))
+
+	info, err := w.WaitInit(xtest.Context(t))
+	require.NoError(t, err)
+	require.Equal(t, lastSeqNo, info.LastSeqNo)
+	require.Equal(t, sessionID, info.SessionID)
+}
+
+func TestWriterImpl_WaitInitAfterReconnect(t *testing.T) {
+	w := newTestWriterStopped(WithAutoSetSeqNo(true))
+
+	w.onWriterChange(&SingleStreamWriter{
+		ReceivedLastSeqNum: 1,
+		SessionID:          "first-session",
+		PartitionID:        1,
+	})
+	w.onWriterChange(nil)
+	w.onWriterChange(&SingleStreamWriter{
+		ReceivedLastSeqNum: 2,
+		SessionID:          "second-session",
+		PartitionID:        2,
+	})
+	require.Equal(t, "second-session", w.sessionID)
+
+	info, err := w.WaitInit(xtest.Context(t))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), info.LastSeqNo)
+	require.Equal(t, "first-session", info.SessionID)
+	require.Equal(t, int64(1), info.PartitionID)
 }
 
 func TestWriterImpl_Reconnect(t *testing.T) {
@@ -619,6 +745,26 @@ func TestCalculateAllowedCodecs(t *testing.T) {
 				rawtopiccommon.CodecGzip,
 			},
 		},
+		{
+			name:         "ForceZstdWithEmptyServer",
+			force:        rawtopiccommon.CodecZstd,
+			serverCodecs: nil,
+			expectedResult: rawtopiccommon.SupportedCodecs{
+				rawtopiccommon.CodecZstd,
+			},
+		},
+		{
+			name:  "NotForcedWithServerZstd",
+			force: rawtopiccommon.CodecUNSPECIFIED,
+			serverCodecs: rawtopiccommon.SupportedCodecs{
+				rawtopiccommon.CodecRaw,
+				rawtopiccommon.CodecZstd,
+			},
+			expectedResult: rawtopiccommon.SupportedCodecs{
+				rawtopiccommon.CodecRaw,
+				rawtopiccommon.CodecZstd,
+			},
+		},
 		{
 			name:  "NotForcedWithServerGzipOnly",
 			force: rawtopiccommon.CodecUNSPECIFIED,
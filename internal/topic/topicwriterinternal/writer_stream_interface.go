@@ -7,5 +7,8 @@ import (
 //go:generate mockgen -source writer_stream_interface.go -destination writer_stream_interface_mock_test.go -package topicwriterinternal -write_package_comment=false
 type StreamWriter interface {
 	Write(ctx context.Context, messages []Message) error
+	WriteWithAck(ctx context.Context, messages []Message) ([]PublicWriteResult, error)
+	Flush(ctx context.Context) error
+	WaitInit(ctx context.Context) (PublicWithOnWriterConnectedInfo, error)
 	Close(ctx context.Context) error
 }
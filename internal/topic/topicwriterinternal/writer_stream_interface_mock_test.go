@@ -47,6 +47,35 @@ func (mr *MockStreamWriterMockRecorder) Close(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStreamWriter)(nil).Close), ctx)
 }
 
+// Flush mocks base method.
+func (m *MockStreamWriter) Flush(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockStreamWriterMockRecorder) Flush(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockStreamWriter)(nil).Flush), ctx)
+}
+
+// WaitInit mocks base method.
+func (m *MockStreamWriter) WaitInit(ctx context.Context) (PublicWithOnWriterConnectedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitInit", ctx)
+	ret0, _ := ret[0].(PublicWithOnWriterConnectedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitInit indicates an expected call of WaitInit.
+func (mr *MockStreamWriterMockRecorder) WaitInit(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitInit", reflect.TypeOf((*MockStreamWriter)(nil).WaitInit), ctx)
+}
+
 // Write mocks base method.
 func (m *MockStreamWriter) Write(ctx context.Context, messages []Message) error {
 	m.ctrl.T.Helper()
@@ -60,3 +89,18 @@ func (mr *MockStreamWriterMockRecorder) Write(ctx, messages interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockStreamWriter)(nil).Write), ctx, messages)
 }
+
+// WriteWithAck mocks base method.
+func (m *MockStreamWriter) WriteWithAck(ctx context.Context, messages []Message) ([]PublicWriteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteWithAck", ctx, messages)
+	ret0, _ := ret[0].([]PublicWriteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteWithAck indicates an expected call of WriteWithAck.
+func (mr *MockStreamWriterMockRecorder) WriteWithAck(ctx, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteWithAck", reflect.TypeOf((*MockStreamWriter)(nil).WriteWithAck), ctx, messages)
+}
@@ -1,4 +1,4 @@
-package testutil
+package value
 
 import (
 	"errors"
@@ -8,15 +8,13 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
-	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
 func TestUnwrapOptionalValue(t *testing.T) {
 	a := allocator.New()
 	defer a.Free()
-	v := value.OptionalValue(value.OptionalValue(value.TextValue("a")))
-	val := unwrapTypedValue(value.ToYDB(v, a))
+	v := OptionalValue(OptionalValue(TextValue("a")))
+	val := unwrapTypedValue(ToYDB(v, a))
 	typeID := val.Type.GetTypeId()
 	if typeID != Ydb.Type_UTF8 {
 		t.Errorf("Types are different: expected %d, actual %d", Ydb.Type_UTF8, typeID)
@@ -31,8 +29,8 @@ func TestUnwrapOptionalValue(t *testing.T) {
 func TestUnwrapPrimitiveValue(t *testing.T) {
 	a := allocator.New()
 	defer a.Free()
-	v := value.TextValue("a")
-	val := unwrapTypedValue(value.ToYDB(v, a))
+	v := TextValue("a")
+	val := unwrapTypedValue(ToYDB(v, a))
 	typeID := val.Type.GetTypeId()
 	if typeID != Ydb.Type_UTF8 {
 		t.Errorf("Types are different: expected %d, actual %d", Ydb.Type_UTF8, typeID)
@@ -47,8 +45,8 @@ func TestUnwrapPrimitiveValue(t *testing.T) {
 func TestUnwrapNullValue(t *testing.T) {
 	a := allocator.New()
 	defer a.Free()
-	v := value.NullValue(value.TypeText)
-	val := unwrapTypedValue(value.ToYDB(v, a))
+	v := NullValue(TypeText)
+	val := unwrapTypedValue(ToYDB(v, a))
 	typeID := val.Type.GetTypeId()
 	if typeID != Ydb.Type_UTF8 {
 		t.Errorf("Types are different: expected %d, actual %d", Ydb.Type_UTF8, typeID)
@@ -60,8 +58,8 @@ func TestUnwrapNullValue(t *testing.T) {
 }
 
 func TestUint8(t *testing.T) {
-	l := types.Uint8Value(byte(1))
-	r := types.Uint8Value(byte(10))
+	l := Uint8Value(byte(1))
+	r := Uint8Value(byte(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -76,8 +74,8 @@ func TestUint8(t *testing.T) {
 }
 
 func TestInt8(t *testing.T) {
-	l := types.Int8Value(int8(1))
-	r := types.Int8Value(int8(10))
+	l := Int8Value(int8(1))
+	r := Int8Value(int8(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -92,8 +90,8 @@ func TestInt8(t *testing.T) {
 }
 
 func TestTimestamp(t *testing.T) {
-	l := types.TimestampValue(1)
-	r := types.TimestampValue(10)
+	l := TimestampValue(1)
+	r := TimestampValue(10)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -108,8 +106,8 @@ func TestTimestamp(t *testing.T) {
 }
 
 func TestDateTime(t *testing.T) {
-	l := types.DatetimeValue(1)
-	r := types.DatetimeValue(10)
+	l := DatetimeValue(1)
+	r := DatetimeValue(10)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -124,8 +122,8 @@ func TestDateTime(t *testing.T) {
 }
 
 func TestUint64(t *testing.T) {
-	l := types.Uint64Value(uint64(1))
-	r := types.Uint64Value(uint64(10))
+	l := Uint64Value(uint64(1))
+	r := Uint64Value(uint64(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -140,8 +138,8 @@ func TestUint64(t *testing.T) {
 }
 
 func TestInt64(t *testing.T) {
-	l := types.Int64Value(int64(1))
-	r := types.Int64Value(int64(10))
+	l := Int64Value(int64(1))
+	r := Int64Value(int64(10))
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -156,8 +154,8 @@ func TestInt64(t *testing.T) {
 }
 
 func TestDouble(t *testing.T) {
-	l := types.DoubleValue(1.0)
-	r := types.DoubleValue(2.0)
+	l := DoubleValue(1.0)
+	r := DoubleValue(2.0)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -172,8 +170,8 @@ func TestDouble(t *testing.T) {
 }
 
 func TestFloat(t *testing.T) {
-	l := types.FloatValue(1.0)
-	r := types.FloatValue(2.0)
+	l := FloatValue(1.0)
+	r := FloatValue(2.0)
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -188,8 +186,8 @@ func TestFloat(t *testing.T) {
 }
 
 func TestUTF8(t *testing.T) {
-	l := types.TextValue("abc")
-	r := types.TextValue("abx")
+	l := TextValue("abc")
+	r := TextValue("abx")
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -204,8 +202,8 @@ func TestUTF8(t *testing.T) {
 }
 
 func TestOptionalUTF8(t *testing.T) {
-	l := types.OptionalValue(types.OptionalValue(types.TextValue("abc")))
-	r := types.TextValue("abx")
+	l := OptionalValue(OptionalValue(TextValue("abc")))
+	r := TextValue("abx")
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -220,8 +218,8 @@ func TestOptionalUTF8(t *testing.T) {
 }
 
 func TestBytes(t *testing.T) {
-	l := types.BytesValue([]byte{1, 2, 3})
-	r := types.BytesValue([]byte{1, 2, 5})
+	l := BytesValue([]byte{1, 2, 3})
+	r := BytesValue([]byte{1, 2, 5})
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -236,8 +234,8 @@ func TestBytes(t *testing.T) {
 }
 
 func TestNull(t *testing.T) {
-	l := types.NullValue(types.TypeText)
-	r := types.TextValue("abc")
+	l := NullValue(TypeText)
+	r := TextValue("abc")
 
 	c, err := Compare(l, r)
 	requireNoError(t, err)
@@ -253,10 +251,10 @@ func TestNull(t *testing.T) {
 }
 
 func TestTuple(t *testing.T) {
-	withNull := types.TupleValue(types.Uint64Value(1), types.NullValue(types.TypeText))
-	least := types.TupleValue(types.Uint64Value(1), types.TextValue("abc"))
-	medium := types.TupleValue(types.Uint64Value(1), types.TextValue("def"))
-	largest := types.TupleValue(types.Uint64Value(2), types.TextValue("abc"))
+	withNull := TupleValue(Uint64Value(1), NullValue(TypeText))
+	least := TupleValue(Uint64Value(1), TextValue("abc"))
+	medium := TupleValue(Uint64Value(1), TextValue("def"))
+	largest := TupleValue(Uint64Value(2), TextValue("abc"))
 
 	c, err := Compare(least, medium)
 	requireNoError(t, err)
@@ -280,9 +278,9 @@ func TestTuple(t *testing.T) {
 }
 
 func TestList(t *testing.T) {
-	least := types.ListValue(types.Uint64Value(1), types.Uint64Value(1))
-	medium := types.ListValue(types.Uint64Value(1), types.Uint64Value(2))
-	largest := types.ListValue(types.Uint64Value(2), types.Uint64Value(1))
+	least := ListValue(Uint64Value(1), Uint64Value(1))
+	medium := ListValue(Uint64Value(1), Uint64Value(2))
+	largest := ListValue(Uint64Value(2), Uint64Value(1))
 
 	c, err := Compare(least, medium)
 	requireNoError(t, err)
@@ -298,8 +296,8 @@ func TestList(t *testing.T) {
 }
 
 func TestDyNumber(t *testing.T) {
-	l := types.DyNumberValue("2")
-	r := types.DyNumberValue("12")
+	l := DyNumberValue("2")
+	r := DyNumberValue("12")
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -314,9 +312,9 @@ func TestDyNumber(t *testing.T) {
 }
 
 func TestUUID(t *testing.T) {
-	l := types.UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
-	r := types.UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
-	g := types.UUIDValue([16]byte{100, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
+	l := UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	r := UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
+	g := UUIDValue([16]byte{100, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
 	c, err := Compare(l, r)
 	requireNoError(t, err)
 	requireEqualValues(t, -1, c)
@@ -335,8 +333,8 @@ func TestUUID(t *testing.T) {
 }
 
 func TestIncompatiblePrimitives(t *testing.T) {
-	l := types.Uint64Value(1)
-	r := types.TimestampValue(2)
+	l := Uint64Value(1)
+	r := TimestampValue(2)
 	_, err := Compare(l, r)
 	if err == nil {
 		t.Errorf("WithStackTrace expected")
@@ -347,8 +345,8 @@ func TestIncompatiblePrimitives(t *testing.T) {
 }
 
 func TestIncompatibleTuples(t *testing.T) {
-	l := types.TupleValue(types.Uint64Value(1), types.TextValue("abc"))
-	r := types.TupleValue(types.Uint64Value(1), types.BytesValue([]byte("abc")))
+	l := TupleValue(Uint64Value(1), TextValue("abc"))
+	r := TupleValue(Uint64Value(1), BytesValue([]byte("abc")))
 	_, err := Compare(l, r)
 	if err == nil {
 		t.Error("WithStackTrace expected")
@@ -358,8 +356,8 @@ func TestIncompatibleTuples(t *testing.T) {
 }
 
 func TestTupleOfDifferentLength(t *testing.T) {
-	l := types.TupleValue(types.Uint64Value(1), types.TextValue("abc"))
-	r := types.TupleValue(types.Uint64Value(1), types.TextValue("abc"), types.TextValue("def"))
+	l := TupleValue(Uint64Value(1), TextValue("abc"))
+	r := TupleValue(Uint64Value(1), TextValue("abc"), TextValue("def"))
 
 	cmp, err := Compare(l, r)
 	requireNoError(t, err)
@@ -371,8 +369,8 @@ func TestTupleOfDifferentLength(t *testing.T) {
 }
 
 func TestTupleInTuple(t *testing.T) {
-	l := types.TupleValue(types.Uint64Value(1), types.TupleValue(types.TextValue("abc"), types.BytesValue([]byte("xyz"))))
-	r := types.TupleValue(types.Uint64Value(1), types.TupleValue(types.TextValue("def"), types.BytesValue([]byte("xyz"))))
+	l := TupleValue(Uint64Value(1), TupleValue(TextValue("abc"), BytesValue([]byte("xyz"))))
+	r := TupleValue(Uint64Value(1), TupleValue(TextValue("def"), BytesValue([]byte("xyz"))))
 
 	cmp, err := Compare(l, r)
 	requireNoError(t, err)
@@ -388,18 +386,18 @@ func TestTupleInTuple(t *testing.T) {
 }
 
 func TestListInList(t *testing.T) {
-	l := types.ListValue(
-		types.ListValue(
-			types.TextValue("abc"), types.TextValue("def"),
-		), types.ListValue(
-			types.TextValue("uvw"), types.TextValue("xyz"),
+	l := ListValue(
+		ListValue(
+			TextValue("abc"), TextValue("def"),
+		), ListValue(
+			TextValue("uvw"), TextValue("xyz"),
 		),
 	)
-	r := types.ListValue(
-		types.ListValue(
-			types.TextValue("abc"), types.TextValue("deg"),
-		), types.ListValue(
-			types.TextValue("uvw"), types.TextValue("xyz"),
+	r := ListValue(
+		ListValue(
+			TextValue("abc"), TextValue("deg"),
+		), ListValue(
+			TextValue("uvw"), TextValue("xyz"),
 		),
 	)
 
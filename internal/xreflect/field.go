@@ -0,0 +1,26 @@
+package xreflect
+
+import (
+	"reflect"
+	"strings"
+)
+
+// DefaultTagNames are names of struct tags which define YDB names of struct fields in order of priority
+var DefaultTagNames = []string{"ydb", "sql"}
+
+// FieldName returns name of struct field from the first of tagNames tags with non-empty name.
+// DefaultTagNames are used if tagNames are not defined.
+// If there is no such tag, FieldName returns Go name of field and tagged is false
+func FieldName(f reflect.StructField, tagNames ...string) (name string, tagged bool) {
+	if len(tagNames) == 0 {
+		tagNames = DefaultTagNames
+	}
+	for _, tagName := range tagNames {
+		if tag, has := f.Tag.Lookup(tagName); has {
+			if name, _, _ = strings.Cut(tag, ","); name != "" {
+				return name, true
+			}
+		}
+	}
+	return f.Name, false
+}
@@ -0,0 +1,43 @@
+package xreflect
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldName(t *testing.T) {
+	type s struct {
+		A int
+		B int `ydb:"b"`
+		C int `sql:"c,omitempty"`
+		D int `ydb:"d" sql:"dd"`
+		E int `ydb:",omitempty" sql:"e"`
+		F int `ydb:"-"`
+		G int `custom:"g"`
+	}
+	for _, tt := range []struct {
+		field    string
+		tagNames []string
+		name     string
+		tagged   bool
+	}{
+		{field: "A", name: "A"},
+		{field: "B", name: "b", tagged: true},
+		{field: "C", name: "c", tagged: true},
+		{field: "D", name: "d", tagged: true},
+		{field: "E", name: "e", tagged: true},
+		{field: "F", name: "-", tagged: true},
+		{field: "G", name: "G"},
+		{field: "G", tagNames: []string{"custom"}, name: "g", tagged: true},
+		{field: "B", tagNames: []string{"custom"}, name: "B"},
+	} {
+		t.Run(tt.field, func(t *testing.T) {
+			f, _ := reflect.TypeOf(s{}).FieldByName(tt.field)
+			name, tagged := FieldName(f, tt.tagNames...)
+			require.Equal(t, tt.name, name)
+			require.Equal(t, tt.tagged, tagged)
+		})
+	}
+}
@@ -475,7 +475,7 @@ func (c *conn) IsColumnExists(ctx context.Context, tableName, columnName string)
 			}
 		}
 		return nil
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 	if err != nil {
 		return false, xerrors.WithStackTrace(err)
 	}
@@ -504,7 +504,7 @@ func (c *conn) GetColumns(ctx context.Context, tableName string) (columns []stri
 			columns = append(columns, desc.Columns[i].Name)
 		}
 		return nil
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -544,7 +544,7 @@ func (c *conn) GetColumnType(ctx context.Context, tableName, columnName string)
 			}
 		}
 		return nil
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 	if err != nil {
 		return "", xerrors.WithStackTrace(err)
 	}
@@ -571,7 +571,7 @@ func (c *conn) GetPrimaryKeys(ctx context.Context, tableName string) (pkCols []s
 		}
 		pkCols = append(pkCols, desc.PrimaryKey...)
 		return nil
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -622,7 +622,7 @@ func (c *conn) GetTables(ctx context.Context, folder string) (tables []string, e
 	err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 		e, err = c.connector.parent.Scheme().DescribePath(ctx, absPath)
 		return err
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -638,7 +638,7 @@ func (c *conn) GetTables(ctx context.Context, folder string) (tables []string, e
 		err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 			d, err = c.connector.parent.Scheme().ListDirectory(ctx, absPath)
 			return err
-		}, retry.WithIdempotent(true))
+		}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
@@ -679,7 +679,7 @@ func (c *conn) GetAllTables(ctx context.Context, folder string) (tables []string
 		err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 			e, err = c.connector.parent.Scheme().DescribePath(ctx, curPath)
 			return err
-		}, retry.WithIdempotent(true))
+		}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
@@ -700,7 +700,7 @@ func (c *conn) GetAllTables(ctx context.Context, folder string) (tables []string
 		err = retry.Retry(ctx, func(ctx context.Context) (err error) {
 			d, err = c.connector.parent.Scheme().ListDirectory(ctx, curPath)
 			return err
-		}, retry.WithIdempotent(true))
+		}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
@@ -739,7 +739,7 @@ func (c *conn) GetIndexes(ctx context.Context, tableName string) (indexes []stri
 			indexes = append(indexes, desc.Indexes[i].Name)
 		}
 		return nil
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -772,7 +772,7 @@ func (c *conn) GetIndexColumns(ctx context.Context, tableName, indexName string)
 			}
 		}
 		return xerrors.WithStackTrace(fmt.Errorf("index '%s' not found in table '%s'", indexName, tableName))
-	}, retry.WithIdempotent(true))
+	}, retry.WithIdempotent(true), retry.WithBudget(c.connector.retryBudget))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
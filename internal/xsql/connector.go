@@ -14,6 +14,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
@@ -139,6 +140,19 @@ func WithIdleThreshold(idleThreshold time.Duration) ConnectorOption {
 	return idleThresholdConnectorOption(idleThreshold)
 }
 
+type retryBudgetConnectorOption struct {
+	budget budget.Budget
+}
+
+func (o retryBudgetConnectorOption) Apply(c *Connector) error {
+	c.retryBudget = o.budget
+	return nil
+}
+
+func WithRetryBudget(b budget.Budget) ConnectorOption {
+	return retryBudgetConnectorOption{budget: b}
+}
+
 type onCloseConnectorOption func(connector *Connector)
 
 func (f onCloseConnectorOption) Apply(c *Connector) error {
@@ -226,6 +240,7 @@ type Connector struct {
 	defaultScanQueryOpts  []options.ExecuteScanQueryOption
 	disableServerBalancer bool
 	idleThreshold         time.Duration
+	retryBudget           budget.Budget
 
 	trace *trace.DatabaseSQL
 }
@@ -318,6 +333,11 @@ type driverWrapper struct {
 	c *Connector
 }
 
+// RetryBudget returns budget of retry attempts of connector for retry.Do and retry.DoTx
+func (d *driverWrapper) RetryBudget() budget.Budget {
+	return d.c.retryBudget
+}
+
 func (d *driverWrapper) Open(_ string) (driver.Conn, error) {
 	return nil, ErrUnsupported
 }
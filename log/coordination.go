@@ -1,10 +1,192 @@
 package log
 
 import (
+	"context"
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 // Coordination makes trace.Coordination with logging events from details
 func Coordination(l Logger, d trace.Detailer, opts ...Option) (t trace.Coordination) {
+	return internalCoordination(wrapLogger(l, opts...), d)
+}
+
+func internalCoordination(l *wrapper, d trace.Detailer) (t trace.Coordination) {
+	t.OnSessionStart = func(
+		info trace.CoordinationSessionStartStartInfo,
+	) func(
+		trace.CoordinationSessionStartDoneInfo,
+	) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, DEBUG, "ydb", "coordination", "session", "start")
+		path := info.Path
+		l.Log(ctx, "start",
+			String("path", path),
+			Int64("session_id", int64(info.SessionID)),
+		)
+		start := time.Now()
+		return func(info trace.CoordinationSessionStartDoneInfo) {
+			if info.Error == nil {
+				l.Log(WithLevel(ctx, INFO), "done",
+					latencyField(start),
+					String("path", path),
+					Int64("session_id", int64(info.SessionID)),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					latencyField(start),
+					String("path", path),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnSessionStop = func(
+		info trace.CoordinationSessionStopStartInfo,
+	) func(
+		trace.CoordinationSessionStopDoneInfo,
+	) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, DEBUG, "ydb", "coordination", "session", "stop")
+		sessionID := info.SessionID
+		l.Log(ctx, "start",
+			Int64("session_id", int64(sessionID)),
+		)
+		start := time.Now()
+		return func(info trace.CoordinationSessionStopDoneInfo) {
+			if info.Error == nil {
+				l.Log(WithLevel(ctx, INFO), "done",
+					latencyField(start),
+					Int64("session_id", int64(sessionID)),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					latencyField(start),
+					Int64("session_id", int64(sessionID)),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnSessionKeepAliveTimeout = func(info trace.CoordinationSessionKeepAliveTimeoutInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "coordination", "session", "keepalive")
+		l.Log(ctx, "timeout",
+			Int64("session_id", int64(info.SessionID)),
+		)
+	}
+	t.OnSessionLost = func(info trace.CoordinationSessionLostInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), ERROR, "ydb", "coordination", "session")
+		l.Log(ctx, "lost",
+			Error(info.Error),
+			Int64("session_id", int64(info.SessionID)),
+			versionField(),
+		)
+	}
+	t.OnElectionCampaign = func(
+		info trace.CoordinationElectionCampaignStartInfo,
+	) func(
+		trace.CoordinationElectionCampaignDoneInfo,
+	) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, DEBUG, "ydb", "coordination", "election", "campaign")
+		path := info.Path
+		name := info.Name
+		l.Log(ctx, "start",
+			String("path", path),
+			String("name", name),
+		)
+		start := time.Now()
+		return func(info trace.CoordinationElectionCampaignDoneInfo) {
+			if info.Error == nil {
+				l.Log(WithLevel(ctx, INFO), "elected",
+					latencyField(start),
+					String("path", path),
+					String("name", name),
+					Int64("session_id", int64(info.SessionID)),
+					Int64("order_id", int64(info.OrderID)),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					latencyField(start),
+					String("path", path),
+					String("name", name),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnElectionResign = func(
+		info trace.CoordinationElectionResignStartInfo,
+	) func(
+		trace.CoordinationElectionResignDoneInfo,
+	) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, DEBUG, "ydb", "coordination", "election", "resign")
+		path := info.Path
+		name := info.Name
+		l.Log(ctx, "start",
+			String("path", path),
+			String("name", name),
+		)
+		start := time.Now()
+		return func(info trace.CoordinationElectionResignDoneInfo) {
+			if info.Error == nil {
+				l.Log(WithLevel(ctx, INFO), "done",
+					latencyField(start),
+					String("path", path),
+					String("name", name),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					latencyField(start),
+					String("path", path),
+					String("name", name),
+					versionField(),
+				)
+			}
+		}
+	}
+	t.OnElectionLeadershipLost = func(info trace.CoordinationElectionLeadershipLostInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "coordination", "election")
+		l.Log(ctx, "leadership lost",
+			String("path", info.Path),
+			String("name", info.Name),
+			Int64("session_id", int64(info.SessionID)),
+		)
+	}
+	t.OnElectionLeaderChanged = func(info trace.CoordinationElectionLeaderChangedInfo) {
+		if d.Details()&trace.CoordinationEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), INFO, "ydb", "coordination", "election")
+		l.Log(ctx, "leader changed",
+			String("path", info.Path),
+			String("name", info.Name),
+			Int64("session_id", int64(info.SessionID)),
+			Int64("order_id", int64(info.OrderID)),
+		)
+	}
 	return t
 }
@@ -81,5 +81,17 @@ func internalRetry(l *wrapper, d trace.Detailer) (t trace.Retry) {
 			}
 		}
 	}
+	t.OnBudgetExhausted = func(info trace.RetryBudgetExhaustedInfo) {
+		if d.Details()&trace.RetryEvents == 0 {
+			return
+		}
+		ctx := with(*info.Context, WARN, "ydb", "retry", "budget")
+		l.Log(ctx, "retry budget exhausted",
+			Error(info.Error),
+			String("id", info.ID),
+			Int("attempts", info.Attempts),
+			versionField(),
+		)
+	}
 	return t
 }
@@ -0,0 +1,87 @@
+//go:build go1.21
+// +build go1.21
+
+package log
+
+import (
+	"context"
+	"log/slog"
+)
+
+var _ Logger = (*slogLogger)(nil)
+
+// FromSlog makes Logger which writes logs into the slog logger l.
+// Levels of logs are mapped to slog levels (TRACE is lower than slog.LevelDebug, FATAL is higher than
+// slog.LevelError). Fields of logs are nested into slog groups by namespace of logs (for example,
+// field "latency" of "ydb.table.session" logs become attribute "ydb.table.session.latency").
+func FromSlog(l *slog.Logger) Logger {
+	return &slogLogger{
+		l: l,
+	}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+func (l *slogLogger) Log(ctx context.Context, msg string, fields ...Field) {
+	lvl := LevelFromContext(ctx)
+	if lvl >= QUIET {
+		return
+	}
+	level := slogLevel(lvl)
+	if !l.l.Enabled(ctx, level) {
+		return
+	}
+	attrs := make([]slog.Attr, 0, len(fields))
+	for i := range fields {
+		attrs = append(attrs, slogAttr(fields[i]))
+	}
+	names := NamesFromContext(ctx)
+	for i := len(names) - 1; i >= 0 && len(attrs) > 0; i-- {
+		attrs = []slog.Attr{
+			{Key: names[i], Value: slog.GroupValue(attrs...)},
+		}
+	}
+	l.l.LogAttrs(ctx, level, msg, attrs...)
+}
+
+func slogLevel(lvl Level) slog.Level {
+	switch lvl {
+	case TRACE:
+		return slog.LevelDebug - 4
+	case DEBUG:
+		return slog.LevelDebug
+	case INFO:
+		return slog.LevelInfo
+	case WARN:
+		return slog.LevelWarn
+	case ERROR:
+		return slog.LevelError
+	default:
+		return slog.LevelError + 4
+	}
+}
+
+func slogAttr(f Field) slog.Attr {
+	switch f.Type() {
+	case IntType:
+		return slog.Int(f.Key(), f.IntValue())
+	case Int64Type:
+		return slog.Int64(f.Key(), f.Int64Value())
+	case StringType:
+		return slog.String(f.Key(), f.StringValue())
+	case BoolType:
+		return slog.Bool(f.Key(), f.BoolValue())
+	case DurationType:
+		return slog.Duration(f.Key(), f.DurationValue())
+	case StringsType:
+		return slog.Any(f.Key(), f.StringsValue())
+	case ErrorType:
+		return slog.Any(f.Key(), f.ErrorValue())
+	case StringerType:
+		return slog.String(f.Key(), f.String())
+	default:
+		return slog.Any(f.Key(), f.AnyValue())
+	}
+}
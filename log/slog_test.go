@@ -0,0 +1,68 @@
+//go:build go1.21
+// +build go1.21
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSlog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := FromSlog(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+
+	ctx := with(context.Background(), WARN, "ydb", "table", "session")
+	l.Log(ctx, "failed",
+		Error(errors.New("test")),
+		Duration("latency", time.Second),
+		Int("count", 1),
+		Int64("id", 2),
+		Bool("idempotent", true),
+		String("name", "test"),
+		Strings("endpoints", []string{"a", "b"}),
+	)
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, map[string]interface{}{
+		"level": "WARN",
+		"msg":   "failed",
+		"ydb": map[string]interface{}{
+			"table": map[string]interface{}{
+				"session": map[string]interface{}{
+					"error":      "test",
+					"latency":    float64(time.Second),
+					"count":      float64(1),
+					"id":         float64(2),
+					"idempotent": true,
+					"name":       "test",
+					"endpoints":  []interface{}{"a", "b"},
+				},
+			},
+		},
+	}, record)
+
+	buf.Reset()
+	l.Log(with(context.Background(), TRACE, "ydb"), "skipped")
+	l.Log(with(context.Background(), QUIET, "ydb"), "skipped")
+	require.Empty(t, buf.String())
+
+	l.Log(with(context.Background(), FATAL, "ydb"), "fatal")
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "ERROR+4", record["level"])
+}
@@ -623,6 +623,55 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			}
 		}
 	}
+	t.OnSessionQueryCache = func(info trace.TableSessionQueryCacheInfo) {
+		if d.Details()&trace.TableSessionQueryInvokeEvents == 0 {
+			return
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "table", "session", "query", "cache")
+		l.Log(ctx, "",
+			String("id", info.Session.ID()),
+			String("query", info.Query),
+			Bool("hit", info.Hit),
+		)
+	}
+	t.OnBulkWriterFlush = func(
+		info trace.TableBulkWriterFlushStartInfo,
+	) func(
+		trace.TableBulkWriterFlushDoneInfo,
+	) {
+		if d.Details()&trace.TableSessionQueryInvokeEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "table", "bulk", "writer", "flush")
+		path := info.Path
+		rows := info.Rows
+		bytes := info.Bytes
+		l.Log(ctx, "start",
+			String("path", path),
+			Int("rows", rows),
+			Int("bytes", bytes),
+		)
+		start := time.Now()
+		return func(info trace.TableBulkWriterFlushDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("path", path),
+					Int("rows", rows),
+					Int("bytes", bytes),
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					latencyField(start),
+					String("path", path),
+					Int("rows", rows),
+					Int("bytes", bytes),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
 	t.OnPoolStateChange = func(info trace.TablePoolStateChangeInfo) {
 		if d.Details()&trace.TablePoolLifeCycleEvents == 0 {
 			return
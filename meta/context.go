@@ -13,6 +13,13 @@ func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return meta.WithTraceID(ctx, traceID)
 }
 
+// WithTraceParent returns a copy of parent context with traceparent header of W3C trace context
+// (https://www.w3.org/TR/trace-context/#traceparent-header).
+// Tracing adapters use it to propagate the client span into YDB
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	return meta.WithTraceParent(ctx, traceParent)
+}
+
 // WithUserAgent returns a copy of parent context with custom user-agent info
 func WithUserAgent(ctx context.Context, userAgent string) context.Context {
 	return meta.WithUserAgent(ctx, userAgent)
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Driver makes trace.Driver with metrics of grpc calls and bans of connections
+func Driver(r Registry, d trace.Detailer) (t trace.Driver) {
+	if d.Details()&trace.DriverConnEvents == 0 {
+		return t
+	}
+	invokeLatency := r.HistogramVec("driver.conn.invoke.latency", latencyBuckets, "method", "status")
+	bans := r.CounterVec("driver.conn.bans", "endpoint", "node_id")
+	t.OnConnInvoke = func(info trace.DriverConnInvokeStartInfo) func(trace.DriverConnInvokeDoneInfo) {
+		method := string(info.Method)
+		start := time.Now()
+		return func(info trace.DriverConnInvokeDoneInfo) {
+			invokeLatency.With(map[string]string{
+				"method": method,
+				"status": status(info.Error),
+			}).Record(time.Since(start).Seconds())
+		}
+	}
+	t.OnConnBan = func(info trace.DriverConnBanStartInfo) func(trace.DriverConnBanDoneInfo) {
+		if info.Endpoint != nil {
+			bans.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  strconv.FormatUint(uint64(info.Endpoint.NodeID()), 10),
+			}).Inc()
+		}
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,170 @@
+package metrics
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type testRegistry struct {
+	mu     sync.Mutex
+	values map[string]float64
+	counts map[string]int
+}
+
+func newTestRegistry() *testRegistry {
+	return &testRegistry{
+		values: make(map[string]float64),
+		counts: make(map[string]int),
+	}
+}
+
+type testCollector struct {
+	r   *testRegistry
+	key string
+}
+
+func (c testCollector) With(labels map[string]string) testCollector {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return testCollector{r: c.r, key: c.key + "{" + strings.Join(pairs, ",") + "}"}
+}
+
+func (c testCollector) Inc() {
+	c.Add(1)
+}
+
+func (c testCollector) Add(delta float64) {
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+	c.r.values[c.key] += delta
+	c.r.counts[c.key]++
+}
+
+func (c testCollector) Set(value float64) {
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+	c.r.values[c.key] = value
+	c.r.counts[c.key]++
+}
+
+func (c testCollector) Record(value float64) {
+	c.Add(value)
+}
+
+type (
+	testCounterVec   struct{ testCollector }
+	testGaugeVec     struct{ testCollector }
+	testHistogramVec struct{ testCollector }
+)
+
+func (v testCounterVec) With(labels map[string]string) Counter {
+	return v.testCollector.With(labels)
+}
+
+func (v testGaugeVec) With(labels map[string]string) Gauge {
+	return v.testCollector.With(labels)
+}
+
+func (v testHistogramVec) With(labels map[string]string) Histogram {
+	return v.testCollector.With(labels)
+}
+
+func (r *testRegistry) CounterVec(name string, _ ...string) CounterVec {
+	return testCounterVec{testCollector{r: r, key: name}}
+}
+
+func (r *testRegistry) GaugeVec(name string, _ ...string) GaugeVec {
+	return testGaugeVec{testCollector{r: r, key: name}}
+}
+
+func (r *testRegistry) HistogramVec(name string, _ []float64, _ ...string) HistogramVec {
+	return testHistogramVec{testCollector{r: r, key: name}}
+}
+
+func TestTable(t *testing.T) {
+	r := newTestRegistry()
+	tt := Table(r, trace.DetailsAll)
+
+	tt.OnPoolStateChange(trace.TablePoolStateChangeInfo{Size: 3, Event: "append"})
+	require.Equal(t, 3.0, r.values["table.pool.size{}"])
+
+	tt.OnDo(trace.TableDoStartInfo{})(trace.TableDoIntermediateInfo{Error: errors.New("")})(
+		trace.TableDoDoneInfo{Attempts: 2},
+	)
+	require.Equal(t, 2.0, r.values["table.retry.attempts{method=do,status=ok}"])
+
+	tt.OnSessionQueryExecute(trace.TableExecuteDataQueryStartInfo{})(
+		trace.TableExecuteDataQueryDoneInfo{Error: errors.New("")},
+	)
+	require.Equal(t, 1, r.counts["table.query.latency{mode=data,status=error}"])
+}
+
+func TestTableDetails(t *testing.T) {
+	r := newTestRegistry()
+	tt := Table(r, trace.TablePoolLifeCycleEvents)
+	require.NotNil(t, tt.OnPoolStateChange)
+	require.Nil(t, tt.OnDo)
+	require.Nil(t, tt.OnSessionQueryExecute)
+}
+
+func TestTopicReaderLag(t *testing.T) {
+	r := newTestRegistry()
+	tt := Topic(r, trace.DetailsAll)
+
+	read := func(start, end int64) {
+		tt.OnReaderReadMessages(trace.TopicReaderReadMessagesStartInfo{})(trace.TopicReaderReadMessagesDoneInfo{
+			MessagesCount: int(end - start),
+			Topic:         "topic",
+			PartitionID:   1,
+			OffsetStart:   start,
+			OffsetEnd:     end,
+		})
+	}
+	commit := func(offset int64) {
+		tt.OnReaderCommittedNotify(trace.TopicReaderCommittedNotifyInfo{
+			Topic:           "topic",
+			PartitionID:     1,
+			CommittedOffset: offset,
+		})
+	}
+	const lag = "topic.reader.lag{partition_id=1,topic=topic}"
+
+	read(10, 15)
+	require.Equal(t, 5.0, r.values[lag])
+	read(15, 20)
+	require.Equal(t, 10.0, r.values[lag])
+	commit(15)
+	require.Equal(t, 5.0, r.values[lag])
+	commit(20)
+	require.Equal(t, 0.0, r.values[lag])
+	require.Equal(t, 10.0, r.values["topic.reader.messages{topic=topic}"])
+
+	read(20, 25)
+	require.Equal(t, 5.0, r.values[lag])
+	tt.OnReaderPartitionReadStopResponse(trace.TopicReaderPartitionReadStopResponseStartInfo{
+		Topic:       "topic",
+		PartitionID: 1,
+	})
+	require.Equal(t, 0.0, r.values[lag], "lag of stopped partition is reset")
+	commit(25)
+	require.Equal(t, 0.0, r.values[lag], "commit of stopped partition is ignored")
+	read(40, 42)
+	require.Equal(t, 2.0, r.values[lag], "offsets of restarted partition are tracked from scratch")
+
+	tt.OnWriterQueueStateChange(trace.TopicWriterQueueStateChangeInfo{
+		WriterInstanceID: "1",
+		Topic:            "topic",
+		Size:             7,
+	})
+	require.Equal(t, 7.0, r.values["topic.writer.queue{topic=topic,writer_id=1}"])
+}
@@ -0,0 +1,62 @@
+// Package metrics makes counters, gauges and histograms from trace events of ydb-go-sdk.
+//
+// Any metrics backend (prometheus, opentelemetry, etc.) can be attached by implementing
+// small Registry interface and passing it into ydb.WithMetrics option.
+package metrics
+
+// Registry makes named collectors with labels.
+// Implementations must return the same collector for repeated calls with the same name
+// because collectors are requested on each attaching of metrics into driver.
+type Registry interface {
+	CounterVec(name string, labelNames ...string) CounterVec
+	GaugeVec(name string, labelNames ...string) GaugeVec
+	// HistogramVec makes histogram with upper bounds of buckets
+	HistogramVec(name string, buckets []float64, labelNames ...string) HistogramVec
+}
+
+// CounterVec is a family of counters with the same label names
+type CounterVec interface {
+	With(labels map[string]string) Counter
+}
+
+// Counter is a monotonically increasing value
+type Counter interface {
+	Inc()
+	Add(delta float64)
+}
+
+// GaugeVec is a family of gauges with the same label names
+type GaugeVec interface {
+	With(labels map[string]string) Gauge
+}
+
+// Gauge is a value which may go up and down
+type Gauge interface {
+	Add(delta float64)
+	Set(value float64)
+}
+
+// HistogramVec is a family of histograms with the same label names
+type HistogramVec interface {
+	With(labels map[string]string) Histogram
+}
+
+// Histogram counts observed values by buckets
+type Histogram interface {
+	Record(value float64)
+}
+
+var (
+	// latencyBuckets are upper bounds of latency histograms in seconds
+	latencyBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30}
+
+	// attemptsBuckets are upper bounds of histograms of retry attempts
+	attemptsBuckets = []float64{1, 2, 3, 5, 10, 20, 50}
+)
+
+func status(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Retry makes trace.Retry with metrics of attempts of retry loops.
+// ydb.WithMetrics attaches it to retry loops of driver clients. Retry loops outside of driver
+// (retry.Retry, retry.Do) need it with retry.WithTrace option
+func Retry(r Registry, d trace.Detailer) (t trace.Retry) {
+	if d.Details()&trace.RetryEvents == 0 {
+		return t
+	}
+	attempts := r.HistogramVec("retry.attempts", attemptsBuckets, "label", "status")
+	t.OnRetry = func(info trace.RetryLoopStartInfo) func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
+		label := info.ID
+		return func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
+			return func(info trace.RetryLoopDoneInfo) {
+				attempts.With(map[string]string{
+					"label":  label,
+					"status": status(info.Error),
+				}).Record(float64(info.Attempts))
+			}
+		}
+	}
+	return t
+}
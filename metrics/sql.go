@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// DatabaseSQL makes trace.DatabaseSQL with metrics of queries and retries of database/sql driver
+func DatabaseSQL(r Registry, d trace.Detailer) (t trace.DatabaseSQL) {
+	if d.Details()&trace.DatabaseSQLConnEvents != 0 {
+		latency := r.HistogramVec("sql.query.latency", latencyBuckets, "mode", "status")
+		record := func(mode string, start time.Time, err error) {
+			latency.With(map[string]string{
+				"mode":   mode,
+				"status": status(err),
+			}).Record(time.Since(start).Seconds())
+		}
+		t.OnConnQuery = func(info trace.DatabaseSQLConnQueryStartInfo) func(trace.DatabaseSQLConnQueryDoneInfo) {
+			mode := info.Mode
+			start := time.Now()
+			return func(info trace.DatabaseSQLConnQueryDoneInfo) {
+				record(mode, start, info.Error)
+			}
+		}
+		t.OnConnExec = func(info trace.DatabaseSQLConnExecStartInfo) func(trace.DatabaseSQLConnExecDoneInfo) {
+			mode := info.Mode
+			start := time.Now()
+			return func(info trace.DatabaseSQLConnExecDoneInfo) {
+				record(mode, start, info.Error)
+			}
+		}
+	}
+	if d.Details()&trace.RetryEvents != 0 {
+		attempts := r.HistogramVec("sql.retry.attempts", attemptsBuckets, "status")
+		t.OnDoTx = func(
+			info trace.DatabaseSQLDoTxStartInfo,
+		) func(
+			trace.DatabaseSQLDoTxIntermediateInfo,
+		) func(
+			trace.DatabaseSQLDoTxDoneInfo,
+		) {
+			return func(trace.DatabaseSQLDoTxIntermediateInfo) func(trace.DatabaseSQLDoTxDoneInfo) {
+				return func(info trace.DatabaseSQLDoTxDoneInfo) {
+					attempts.With(map[string]string{
+						"status": status(info.Error),
+					}).Record(float64(info.Attempts))
+				}
+			}
+		}
+	}
+	return t
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Table makes trace.Table with metrics of session pool, queries and retries
+func Table(r Registry, d trace.Detailer) (t trace.Table) {
+	if d.Details()&trace.TablePoolLifeCycleEvents != 0 {
+		size := r.GaugeVec("table.pool.size")
+		t.OnPoolStateChange = func(info trace.TablePoolStateChangeInfo) {
+			size.With(nil).Set(float64(info.Size))
+		}
+	}
+	if d.Details()&trace.TablePoolAPIEvents != 0 {
+		wait := r.HistogramVec("table.pool.wait", latencyBuckets, "status")
+		attempts := r.HistogramVec("table.retry.attempts", attemptsBuckets, "method", "status")
+		t.OnPoolGet = func(info trace.TablePoolGetStartInfo) func(trace.TablePoolGetDoneInfo) {
+			start := time.Now()
+			return func(info trace.TablePoolGetDoneInfo) {
+				wait.With(map[string]string{
+					"status": status(info.Error),
+				}).Record(time.Since(start).Seconds())
+			}
+		}
+		t.OnDo = func(info trace.TableDoStartInfo) func(trace.TableDoIntermediateInfo) func(trace.TableDoDoneInfo) {
+			return func(trace.TableDoIntermediateInfo) func(trace.TableDoDoneInfo) {
+				return func(info trace.TableDoDoneInfo) {
+					attempts.With(map[string]string{
+						"method": "do",
+						"status": status(info.Error),
+					}).Record(float64(info.Attempts))
+				}
+			}
+		}
+		t.OnDoTx = func(
+			info trace.TableDoTxStartInfo,
+		) func(
+			trace.TableDoTxIntermediateInfo,
+		) func(
+			trace.TableDoTxDoneInfo,
+		) {
+			return func(trace.TableDoTxIntermediateInfo) func(trace.TableDoTxDoneInfo) {
+				return func(info trace.TableDoTxDoneInfo) {
+					attempts.With(map[string]string{
+						"method": "do_tx",
+						"status": status(info.Error),
+					}).Record(float64(info.Attempts))
+				}
+			}
+		}
+	}
+	queryEvents := trace.TableSessionQueryInvokeEvents |
+		trace.TableSessionQueryStreamEvents |
+		trace.TableSessionTransactionEvents
+	if d.Details()&queryEvents == 0 {
+		return t
+	}
+	latency := r.HistogramVec("table.query.latency", latencyBuckets, "mode", "status")
+	record := func(mode string, start time.Time, err error) {
+		latency.With(map[string]string{
+			"mode":   mode,
+			"status": status(err),
+		}).Record(time.Since(start).Seconds())
+	}
+	if d.Details()&trace.TableSessionQueryInvokeEvents != 0 {
+		t.OnSessionQueryExecute = func(
+			info trace.TableExecuteDataQueryStartInfo,
+		) func(
+			trace.TableExecuteDataQueryDoneInfo,
+		) {
+			start := time.Now()
+			return func(info trace.TableExecuteDataQueryDoneInfo) {
+				record("data", start, info.Error)
+			}
+		}
+	}
+	if d.Details()&trace.TableSessionQueryStreamEvents != 0 {
+		t.OnSessionQueryStreamExecute = func(
+			info trace.TableSessionQueryStreamExecuteStartInfo,
+		) func(
+			trace.TableSessionQueryStreamExecuteIntermediateInfo,
+		) func(
+			trace.TableSessionQueryStreamExecuteDoneInfo,
+		) {
+			start := time.Now()
+			return func(
+				trace.TableSessionQueryStreamExecuteIntermediateInfo,
+			) func(
+				trace.TableSessionQueryStreamExecuteDoneInfo,
+			) {
+				return func(info trace.TableSessionQueryStreamExecuteDoneInfo) {
+					record("scan", start, info.Error)
+				}
+			}
+		}
+	}
+	if d.Details()&trace.TableSessionTransactionEvents != 0 {
+		t.OnSessionTransactionExecute = func(
+			info trace.TableTransactionExecuteStartInfo,
+		) func(
+			trace.TableTransactionExecuteDoneInfo,
+		) {
+			start := time.Now()
+			return func(info trace.TableTransactionExecuteDoneInfo) {
+				record("data", start, info.Error)
+			}
+		}
+	}
+	return t
+}
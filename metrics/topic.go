@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Topic makes trace.Topic with metrics of topic readers and writers.
+//
+// Lag of reader is a count of messages which are read from partition but not committed yet.
+// Lag of partition is reset to zero when partition session is stopped.
+// Queue of writer is a count of messages which are written but not acked by server yet.
+func Topic(r Registry, d trace.Detailer) (t trace.Topic) {
+	if d.Details()&trace.TopicReaderMessageEvents != 0 {
+		messages := r.CounterVec("topic.reader.messages", "topic")
+		lag := r.GaugeVec("topic.reader.lag", "topic", "partition_id")
+		offsets := &partitionOffsets{
+			partitions: make(map[partitionKey]partitionOffset),
+		}
+		t.OnReaderReadMessages = func(
+			info trace.TopicReaderReadMessagesStartInfo,
+		) func(
+			trace.TopicReaderReadMessagesDoneInfo,
+		) {
+			return func(info trace.TopicReaderReadMessagesDoneInfo) {
+				if info.Error != nil {
+					return
+				}
+				messages.With(map[string]string{"topic": info.Topic}).Add(float64(info.MessagesCount))
+				key := partitionKey{topic: info.Topic, partitionID: info.PartitionID}
+				lag.With(key.labels()).Set(float64(offsets.read(key, info.OffsetStart, info.OffsetEnd)))
+			}
+		}
+		t.OnReaderCommittedNotify = func(info trace.TopicReaderCommittedNotifyInfo) {
+			key := partitionKey{topic: info.Topic, partitionID: info.PartitionID}
+			lag.With(key.labels()).Set(float64(offsets.committed(key, info.CommittedOffset)))
+		}
+		t.OnReaderPartitionReadStopResponse = func(
+			info trace.TopicReaderPartitionReadStopResponseStartInfo,
+		) func(
+			trace.TopicReaderPartitionReadStopResponseDoneInfo,
+		) {
+			key := partitionKey{topic: info.Topic, partitionID: info.PartitionID}
+			offsets.stop(key)
+			lag.With(key.labels()).Set(0)
+			return nil
+		}
+	}
+	if d.Details()&trace.TopicWriterStreamEvents != 0 {
+		queue := r.GaugeVec("topic.writer.queue", "topic", "writer_id")
+		t.OnWriterQueueStateChange = func(info trace.TopicWriterQueueStateChangeInfo) {
+			queue.With(map[string]string{
+				"topic":     info.Topic,
+				"writer_id": info.WriterInstanceID,
+			}).Set(float64(info.Size))
+		}
+	}
+	return t
+}
+
+type partitionKey struct {
+	topic       string
+	partitionID int64
+}
+
+func (k partitionKey) labels() map[string]string {
+	return map[string]string{
+		"topic":        k.topic,
+		"partition_id": strconv.FormatInt(k.partitionID, 10),
+	}
+}
+
+type partitionOffset struct {
+	read      int64
+	committed int64
+}
+
+// partitionOffsets tracks end offsets of read and committed messages by partitions
+type partitionOffsets struct {
+	mu         sync.Mutex
+	partitions map[partitionKey]partitionOffset
+}
+
+func (o *partitionOffsets) read(key partitionKey, start, end int64) (lag int64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	p, has := o.partitions[key]
+	if !has {
+		// messages before the first read message are committed
+		p.committed = start
+	}
+	if end > p.read {
+		p.read = end
+	}
+	o.partitions[key] = p
+	return p.lag()
+}
+
+func (o *partitionOffsets) committed(key partitionKey, offset int64) (lag int64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	p, has := o.partitions[key]
+	if !has {
+		// partition is stopped or nothing is read from it
+		return 0
+	}
+	if offset > p.committed {
+		p.committed = offset
+	}
+	o.partitions[key] = p
+	return p.lag()
+}
+
+// stop forgets offsets of partition because partition may be read by other reader after stop
+func (o *partitionOffsets) stop(key partitionKey) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.partitions, key)
+}
+
+func (p partitionOffset) lag() int64 {
+	if p.read < p.committed {
+		return 0
+	}
+	return p.read - p.committed
+}
@@ -0,0 +1,51 @@
+package operation_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/export"
+	"github.com/ydb-platform/ydb-go-sdk/v3/export/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+)
+
+//nolint:errcheck
+func Example() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	op, err := db.Export().ExportToS3(ctx,
+		export.S3{
+			Endpoint:  "storage.yandexcloud.net",
+			Bucket:    "backups",
+			AccessKey: "access-key",
+			SecretKey: "secret-key",
+		},
+		[]export.Item{
+			{SourcePath: "/local/series", DestinationPrefix: "daily/series"},
+		},
+		options.WithCompression("zstd"),
+	)
+	if err != nil {
+		fmt.Printf("failed to start export: %v", err)
+		return
+	}
+	if _, err = db.Operation().Wait(ctx, op.ID); err != nil {
+		fmt.Printf("export failed: %v", err)
+		return
+	}
+	defer db.Operation().Forget(ctx, op.ID)
+	operations, _, err := db.Operation().List(ctx, operation.KindExport)
+	if err != nil {
+		fmt.Printf("failed to list operations: %v", err)
+		return
+	}
+	for _, op := range operations {
+		fmt.Printf("export operation %q (ready: %v)\n", op.ID, op.Ready)
+	}
+}
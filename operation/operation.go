@@ -0,0 +1,52 @@
+package operation
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation/options"
+)
+
+// Kinds of long-running operations for Client.List
+const (
+	KindBuildIndex = "buildindex"
+	KindExport     = "export"
+	KindImport     = "import"
+)
+
+// Client controls long-running operations which are started with operation.ModeAsync
+// (build index, export, import and so on)
+type Client interface {
+	// Get returns the actual state of the operation with id.
+	// If the operation is completed with failure, Get returns the error of the operation.
+	Get(ctx context.Context, id string) (*Operation, error)
+
+	// Cancel starts cancellation of the operation with id
+	Cancel(ctx context.Context, id string) error
+
+	// Forget forgets the operation with id. Forgotten operation is not available for Get and List.
+	// Forget does not cancel the operation.
+	Forget(ctx context.Context, id string) error
+
+	// List returns a page of operations of kind and the token of the next page.
+	// The next page token is empty on the last page.
+	List(ctx context.Context, kind string, opts ...options.ListOption) (
+		operations []*Operation, nextPageToken string, err error,
+	)
+
+	// Wait polls the operation with id with backoff until the operation is ready.
+	// If the operation is completed with failure, Wait returns the error of the operation.
+	Wait(ctx context.Context, id string, opts ...options.WaitOption) (*Operation, error)
+}
+
+// Operation is a state of the long-running operation
+type Operation struct {
+	// ID is an identifier of the operation
+	ID string
+	// Ready is true if the operation is completed
+	Ready bool
+	// Metadata is a service specific progress of the operation,
+	// for example Ydb_Export.ExportToS3Metadata for export to S3
+	Metadata *anypb.Any
+}
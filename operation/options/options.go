@@ -0,0 +1,59 @@
+package options
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+// DefaultWaitBackoff is a default backoff between polls of Client.Wait
+var DefaultWaitBackoff = retry.Backoff(100*time.Millisecond, 6, 0.5)
+
+// ListOption configures the listing of operations
+type ListOption func(request *Ydb_Operations.ListOperationsRequest)
+
+// WithPageSize specifies the maximum number of operations in the page
+func WithPageSize(pageSize uint64) ListOption {
+	return func(request *Ydb_Operations.ListOperationsRequest) {
+		request.PageSize = pageSize
+	}
+}
+
+// WithPageToken specifies the token of the page which was returned by the previous call of Client.List
+func WithPageToken(pageToken string) ListOption {
+	return func(request *Ydb_Operations.ListOperationsRequest) {
+		request.PageToken = pageToken
+	}
+}
+
+// WaitOptions contains settings of waiting for the operation
+type WaitOptions struct {
+	Backoff backoff.Backoff
+}
+
+// WaitOption configures waiting for the operation
+type WaitOption func(o *WaitOptions)
+
+// WithBackoff specifies the backoff between polls of the operation.
+// Use retry.Backoff for making a custom backoff.
+func WithBackoff(b backoff.Backoff) WaitOption {
+	return func(o *WaitOptions) {
+		o.Backoff = b
+	}
+}
+
+// NewWaitOptions makes wait options with defaults and applies opts over them
+func NewWaitOptions(opts ...WaitOption) *WaitOptions {
+	o := &WaitOptions{
+		Backoff: DefaultWaitBackoff,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/metrics"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -173,6 +175,20 @@ func WithLogger(l log.Logger, details trace.Detailer, opts ...log.Option) Option
 	}
 }
 
+// WithMetrics enables metrics of selected tracing events.
+//
+// Metrics of retries are collected for retry loops of driver clients (table, topic, etc.).
+// Retry loops outside of driver (retry.Retry, retry.Do) need metrics.Retry with retry.WithTrace option.
+//
+// Registry is an adapter of metrics backend. See metrics package documentation for details.
+func WithMetrics(registry metrics.Registry, details trace.Detailer) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.metrics = registry
+		c.metricsDetails = details
+		return nil
+	}
+}
+
 // WithAnonymousCredentials force to make requests withou authentication.
 func WithAnonymousCredentials() Option {
 	return WithCredentials(
@@ -374,6 +390,15 @@ func WithSessionPoolDeleteTimeout(deleteTimeout time.Duration) Option {
 	}
 }
 
+// WithPreparedStatementsCacheSize enables per-session LRU cache of prepared statements in table.Client.
+// Session.Execute transparently uses cached prepared statement for repeated query text
+func WithPreparedStatementsCacheSize(size int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithPreparedStatementsCacheSize(size))
+		return nil
+	}
+}
+
 // WithIgnoreTruncated disables errors on truncated flag
 func WithIgnoreTruncated() Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -394,6 +419,45 @@ func WithPanicCallback(panicCallback func(e interface{})) Option {
 	}
 }
 
+// WithCircuitBreaker enables per-endpoint circuit breaker which bans endpoint after failures
+// consecutive pessimizing errors and allows trial request to endpoint after cooldown
+func WithCircuitBreaker(failures int, cooldown time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithCircuitBreaker(failures, cooldown))
+		return nil
+	}
+}
+
+// WithRetryBudget sets budget of retry attempts which shared between all retry loops of driver:
+// table.Client.Do and DoTx, database/sql connectors and topic readers and writers reconnections
+func WithRetryBudget(b budget.Budget) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithRetryBudget(b))
+		return nil
+	}
+}
+
+// WithTraceRetry returns retry trace option.
+// Trace applies to internal retry loops of driver (table.Client.Do, table.Client.DoTx and reconnects of topic
+// reader and writer) and reports exhaustion of retry budget (see WithRetryBudget)
+func WithTraceRetry(t trace.Retry, opts ...trace.RetryComposeOption) Option { //nolint:gocritic
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(
+			c.options,
+			config.WithTraceRetry(
+				t,
+				append(
+					[]trace.RetryComposeOption{
+						trace.WithRetryPanicCallback(c.panicCallback),
+					},
+					opts...,
+				)...,
+			),
+		)
+		return nil
+	}
+}
+
 // WithTraceTable returns table trace option
 func WithTraceTable(t trace.Table, opts ...trace.TableComposeOption) Option { //nolint:gocritic
 	return func(ctx context.Context, c *Driver) error {
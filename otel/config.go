@@ -0,0 +1,54 @@
+package otel
+
+import (
+	"go.opentelemetry.io/otel"
+	otelTrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const instrumentationName = "github.com/ydb-platform/ydb-go-sdk/v3/otel"
+
+type config struct {
+	tracer    otelTrace.Tracer
+	details   trace.Details
+	queryText bool
+}
+
+type Option func(c *config)
+
+// WithTracerProvider specifies the provider of tracer of spans.
+// Global tracer provider (otel.GetTracerProvider) is used by default
+func WithTracerProvider(provider otelTrace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracer = provider.Tracer(instrumentationName)
+	}
+}
+
+// WithDetails specifies the events which are turned into spans. All events are traced by default
+func WithDetails(d trace.Detailer) Option {
+	return func(c *config) {
+		c.details = d.Details()
+	}
+}
+
+// WithQueryText adds the text of queries into spans (attribute "db.statement").
+// Spans contains only the hash of query text by default because queries may contain sensitive data
+func WithQueryText() Option {
+	return func(c *config) {
+		c.queryText = true
+	}
+}
+
+func newConfig(opts ...Option) *config {
+	c := &config{
+		tracer:  otel.GetTracerProvider().Tracer(instrumentationName),
+		details: trace.DetailsAll,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(c)
+		}
+	}
+	return c
+}
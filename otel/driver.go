@@ -0,0 +1,58 @@
+package otel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Driver makes trace.Driver with spans of grpc calls
+func Driver(opts ...Option) trace.Driver {
+	return driver(newConfig(opts...))
+}
+
+func driver(c *config) (t trace.Driver) {
+	if c.details&trace.DriverConnEvents == 0 {
+		return t
+	}
+	t.OnConnInvoke = func(info trace.DriverConnInvokeStartInfo) func(trace.DriverConnInvokeDoneInfo) {
+		span := c.startCall(info.Context, "ydb.conn.invoke",
+			append(endpointAttributes(info.Endpoint), MethodKey.String(string(info.Method)))...,
+		)
+		return func(info trace.DriverConnInvokeDoneInfo) {
+			if info.OpID != "" {
+				finish(span, info.Error, OperationIDKey.String(info.OpID))
+			} else {
+				finish(span, info.Error)
+			}
+		}
+	}
+	t.OnConnNewStream = func(
+		info trace.DriverConnNewStreamStartInfo,
+	) func(
+		trace.DriverConnNewStreamRecvInfo,
+	) func(
+		trace.DriverConnNewStreamDoneInfo,
+	) {
+		span := c.startCall(info.Context, "ydb.conn.stream",
+			append(endpointAttributes(info.Endpoint), MethodKey.String(string(info.Method)))...,
+		)
+		return func(trace.DriverConnNewStreamRecvInfo) func(trace.DriverConnNewStreamDoneInfo) {
+			return func(info trace.DriverConnNewStreamDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+	}
+	return t
+}
+
+func endpointAttributes(endpoint trace.EndpointInfo) []attribute.KeyValue {
+	if endpoint == nil {
+		return nil
+	}
+	return []attribute.KeyValue{
+		EndpointKey.String(endpoint.Address()),
+		NodeIDKey.Int64(int64(endpoint.NodeID())),
+		LocationKey.String(endpoint.Location()),
+	}
+}
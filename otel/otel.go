@@ -0,0 +1,96 @@
+// Package otel makes OpenTelemetry spans from trace events of ydb-go-sdk.
+//
+// Spans of grpc calls propagate W3C trace context (traceparent header) into YDB,
+// so the traces of application include the work of YDB nodes.
+package otel
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	otelTrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
+)
+
+// YDB-specific attributes of spans
+const (
+	EndpointKey    = attribute.Key("ydb.endpoint")
+	NodeIDKey      = attribute.Key("ydb.node.id")
+	LocationKey    = attribute.Key("ydb.node.location")
+	MethodKey      = attribute.Key("rpc.method")
+	OperationIDKey = attribute.Key("ydb.operation.id")
+	SessionIDKey   = attribute.Key("ydb.session.id")
+	TxIDKey        = attribute.Key("ydb.tx.id")
+	QueryHashKey   = attribute.Key("ydb.query.hash")
+	QueryTextKey   = attribute.Key("db.statement")
+	QueryModeKey   = attribute.Key("ydb.query.mode")
+	IdempotentKey  = attribute.Key("ydb.idempotent")
+	AttemptKey     = attribute.Key("ydb.retry.attempt")
+	AttemptsKey    = attribute.Key("ydb.retry.attempts")
+	RetryIDKey     = attribute.Key("ydb.retry.id")
+	TopicKey       = attribute.Key("ydb.topic")
+	PartitionIDKey = attribute.Key("ydb.topic.partition.id")
+	ProducerIDKey  = attribute.Key("ydb.topic.producer.id")
+	MessagesKey    = attribute.Key("ydb.topic.messages")
+)
+
+// WithTraces returns ydb.Option which makes spans from events of driver, table, database/sql and topic clients
+func WithTraces(opts ...Option) ydb.Option {
+	c := newConfig(opts...)
+	return ydb.MergeOptions(
+		ydb.WithTraceDriver(driver(c)),
+		ydb.WithTraceTable(table(c)),
+		ydb.WithTraceDatabaseSQL(databaseSQL(c)),
+		ydb.WithTraceTopic(topic(c)),
+	)
+}
+
+var propagator = propagation.TraceContext{}
+
+func (c *config) start(ctx *context.Context, name string, attrs ...attribute.KeyValue) otelTrace.Span {
+	childCtx, span := c.tracer.Start(*ctx, name,
+		otelTrace.WithSpanKind(otelTrace.SpanKindClient),
+		otelTrace.WithAttributes(attrs...),
+	)
+	*ctx = childCtx
+	return span
+}
+
+// startCall starts span of the grpc call and injects trace context of the span into headers of the call
+func (c *config) startCall(ctx *context.Context, name string, attrs ...attribute.KeyValue) otelTrace.Span {
+	span := c.start(ctx, name, attrs...)
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(*ctx, carrier)
+	if traceParent := carrier.Get("traceparent"); traceParent != "" {
+		*ctx = meta.WithTraceParent(*ctx, traceParent)
+	}
+	return span
+}
+
+func (c *config) queryAttributes(query string) []attribute.KeyValue {
+	if c.queryText {
+		return []attribute.KeyValue{QueryHashKey.String(queryHash(query)), QueryTextKey.String(query)}
+	}
+	return []attribute.KeyValue{QueryHashKey.String(queryHash(query))}
+}
+
+func finish(span otelTrace.Span, err error, attrs ...attribute.KeyValue) {
+	span.SetAttributes(attrs...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func queryHash(query string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(query))
+	return strconv.FormatUint(h.Sum64(), 16)
+}
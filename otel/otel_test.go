@@ -0,0 +1,73 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func newRecorder() (*tracetest.SpanRecorder, Option) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdkTrace.NewTracerProvider(sdkTrace.WithSpanProcessor(recorder))
+	return recorder, WithTracerProvider(provider)
+}
+
+func TestDriverConnInvoke(t *testing.T) {
+	recorder, opt := newRecorder()
+	d := Driver(opt)
+
+	ctx := context.Background()
+	done := d.OnConnInvoke(trace.DriverConnInvokeStartInfo{
+		Context: &ctx,
+		Method:  "/Ydb.Table.V1.TableService/ExecuteDataQuery",
+	})
+	md, has := metadata.FromOutgoingContext(ctx)
+	require.True(t, has)
+	require.Len(t, md.Get("traceparent"), 1)
+
+	done(trace.DriverConnInvokeDoneInfo{OpID: "op"})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "ydb.conn.invoke", spans[0].Name())
+	require.Contains(t, md.Get("traceparent")[0], spans[0].SpanContext().SpanID().String())
+}
+
+func TestRetry(t *testing.T) {
+	recorder, opt := newRecorder()
+	r := Retry(opt)
+
+	ctx := context.Background()
+	errAttempt := errors.New("attempt")
+	intermediate := r.OnRetry(trace.RetryLoopStartInfo{Context: &ctx, ID: "test", Idempotent: true})
+	intermediate(trace.RetryLoopIntermediateInfo{Error: errAttempt})
+	intermediate(trace.RetryLoopIntermediateInfo{Error: errAttempt})
+	intermediate(trace.RetryLoopIntermediateInfo{})(trace.RetryLoopDoneInfo{Attempts: 3})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "ydb.retry", spans[0].Name())
+	require.Len(t, spans[0].Events(), 2)
+	require.Contains(t, spans[0].Attributes(), AttemptsKey.Int(3))
+	require.Contains(t, spans[0].Attributes(), RetryIDKey.String("test"))
+}
+
+func TestDetails(t *testing.T) {
+	_, opt := newRecorder()
+	d := Driver(opt, WithDetails(trace.TableEvents))
+	require.Nil(t, d.OnConnInvoke)
+}
+
+func TestQueryAttributes(t *testing.T) {
+	c := newConfig()
+	require.Len(t, c.queryAttributes("SELECT 1"), 1)
+	c = newConfig(WithQueryText())
+	require.Contains(t, c.queryAttributes("SELECT 1"), QueryTextKey.String("SELECT 1"))
+}
@@ -0,0 +1,31 @@
+package otel
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Retry makes trace.Retry with spans of retry loops for retry.WithTrace and retry.Do options
+func Retry(opts ...Option) trace.Retry {
+	return retry(newConfig(opts...))
+}
+
+func retry(c *config) (t trace.Retry) {
+	if c.details&trace.RetryEvents == 0 {
+		return t
+	}
+	t.OnRetry = func(info trace.RetryLoopStartInfo) func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
+		s := &retrySpan{
+			span: c.start(info.Context, "ydb.retry", IdempotentKey.Bool(info.Idempotent)),
+		}
+		if info.ID != "" {
+			s.span.SetAttributes(RetryIDKey.String(info.ID))
+		}
+		return func(info trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
+			s.intermediate(info.Error)
+			return func(info trace.RetryLoopDoneInfo) {
+				s.done(info.Attempts, info.Error)
+			}
+		}
+	}
+	return t
+}
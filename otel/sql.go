@@ -0,0 +1,124 @@
+package otel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// DatabaseSQL makes trace.DatabaseSQL with spans of database/sql calls
+func DatabaseSQL(opts ...Option) trace.DatabaseSQL {
+	return databaseSQL(newConfig(opts...))
+}
+
+//nolint:funlen
+func databaseSQL(c *config) (t trace.DatabaseSQL) {
+	if c.details&trace.DatabaseSQLConnEvents != 0 {
+		t.OnConnBegin = func(info trace.DatabaseSQLConnBeginStartInfo) func(trace.DatabaseSQLConnBeginDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.conn.begin")
+			return func(info trace.DatabaseSQLConnBeginDoneInfo) {
+				if info.Error == nil && info.Tx != nil {
+					finish(span, nil, TxIDKey.String(info.Tx.ID()))
+				} else {
+					finish(span, info.Error)
+				}
+			}
+		}
+		t.OnConnQuery = func(info trace.DatabaseSQLConnQueryStartInfo) func(trace.DatabaseSQLConnQueryDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.conn.query",
+				append(c.queryAttributes(info.Query),
+					QueryModeKey.String(info.Mode),
+					IdempotentKey.Bool(info.Idempotent),
+				)...,
+			)
+			return func(info trace.DatabaseSQLConnQueryDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+		t.OnConnExec = func(info trace.DatabaseSQLConnExecStartInfo) func(trace.DatabaseSQLConnExecDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.conn.exec",
+				append(c.queryAttributes(info.Query),
+					QueryModeKey.String(info.Mode),
+					IdempotentKey.Bool(info.Idempotent),
+				)...,
+			)
+			return func(info trace.DatabaseSQLConnExecDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+	}
+	if c.details&trace.DatabaseSQLTxEvents != 0 {
+		t.OnTxQuery = func(info trace.DatabaseSQLTxQueryStartInfo) func(trace.DatabaseSQLTxQueryDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.tx.query",
+				append(c.queryAttributes(info.Query), txAttributes(info.Tx)...)...,
+			)
+			return func(info trace.DatabaseSQLTxQueryDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+		t.OnTxExec = func(info trace.DatabaseSQLTxExecStartInfo) func(trace.DatabaseSQLTxExecDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.tx.exec",
+				append(c.queryAttributes(info.Query), txAttributes(info.Tx)...)...,
+			)
+			return func(info trace.DatabaseSQLTxExecDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+		t.OnTxCommit = func(info trace.DatabaseSQLTxCommitStartInfo) func(trace.DatabaseSQLTxCommitDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.tx.commit", txAttributes(info.Tx)...)
+			return func(info trace.DatabaseSQLTxCommitDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+		t.OnTxRollback = func(info trace.DatabaseSQLTxRollbackStartInfo) func(trace.DatabaseSQLTxRollbackDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.tx.rollback", txAttributes(info.Tx)...)
+			return func(info trace.DatabaseSQLTxRollbackDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+	}
+	if c.details&trace.DatabaseSQLStmtEvents != 0 {
+		t.OnStmtQuery = func(info trace.DatabaseSQLStmtQueryStartInfo) func(trace.DatabaseSQLStmtQueryDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.stmt.query", c.queryAttributes(info.Query)...)
+			return func(info trace.DatabaseSQLStmtQueryDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+		t.OnStmtExec = func(info trace.DatabaseSQLStmtExecStartInfo) func(trace.DatabaseSQLStmtExecDoneInfo) {
+			span := c.start(info.Context, "ydb.sql.stmt.exec", c.queryAttributes(info.Query)...)
+			return func(info trace.DatabaseSQLStmtExecDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+	}
+	if c.details&trace.RetryEvents != 0 {
+		t.OnDoTx = func(
+			info trace.DatabaseSQLDoTxStartInfo,
+		) func(
+			trace.DatabaseSQLDoTxIntermediateInfo,
+		) func(
+			trace.DatabaseSQLDoTxDoneInfo,
+		) {
+			s := &retrySpan{
+				span: c.start(info.Context, "ydb.sql.do_tx", IdempotentKey.Bool(info.Idempotent)),
+			}
+			if info.ID != "" {
+				s.span.SetAttributes(RetryIDKey.String(info.ID))
+			}
+			return func(info trace.DatabaseSQLDoTxIntermediateInfo) func(trace.DatabaseSQLDoTxDoneInfo) {
+				s.intermediate(info.Error)
+				return func(info trace.DatabaseSQLDoTxDoneInfo) {
+					s.done(info.Attempts, info.Error)
+				}
+			}
+		}
+	}
+	return t
+}
+
+func txAttributes(tx interface{ ID() string }) []attribute.KeyValue {
+	if tx == nil {
+		return nil
+	}
+	return []attribute.KeyValue{TxIDKey.String(tx.ID())}
+}
@@ -0,0 +1,194 @@
+package otel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	otelTrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Table makes trace.Table with spans of retry loops, sessions, queries and transactions
+func Table(opts ...Option) trace.Table {
+	return table(newConfig(opts...))
+}
+
+//nolint:funlen
+func table(c *config) (t trace.Table) {
+	if c.details&trace.TablePoolAPIEvents != 0 {
+		t.OnDo = func(info trace.TableDoStartInfo) func(trace.TableDoIntermediateInfo) func(trace.TableDoDoneInfo) {
+			s := &retrySpan{
+				span: c.start(info.Context, "ydb.table.do", IdempotentKey.Bool(info.Idempotent)),
+			}
+			return func(info trace.TableDoIntermediateInfo) func(trace.TableDoDoneInfo) {
+				s.intermediate(info.Error)
+				return func(info trace.TableDoDoneInfo) {
+					s.done(info.Attempts, info.Error)
+				}
+			}
+		}
+		t.OnDoTx = func(
+			info trace.TableDoTxStartInfo,
+		) func(
+			trace.TableDoTxIntermediateInfo,
+		) func(
+			trace.TableDoTxDoneInfo,
+		) {
+			s := &retrySpan{
+				span: c.start(info.Context, "ydb.table.do_tx", IdempotentKey.Bool(info.Idempotent)),
+			}
+			return func(info trace.TableDoTxIntermediateInfo) func(trace.TableDoTxDoneInfo) {
+				s.intermediate(info.Error)
+				return func(info trace.TableDoTxDoneInfo) {
+					s.done(info.Attempts, info.Error)
+				}
+			}
+		}
+		t.OnPoolGet = func(info trace.TablePoolGetStartInfo) func(trace.TablePoolGetDoneInfo) {
+			span := c.start(info.Context, "ydb.table.pool.get")
+			return func(info trace.TablePoolGetDoneInfo) {
+				if info.Error == nil && info.Session != nil {
+					finish(span, nil, SessionIDKey.String(info.Session.ID()), AttemptsKey.Int(info.Attempts))
+				} else {
+					finish(span, info.Error, AttemptsKey.Int(info.Attempts))
+				}
+			}
+		}
+	}
+	if c.details&trace.TableSessionLifeCycleEvents != 0 {
+		t.OnSessionNew = func(info trace.TableSessionNewStartInfo) func(trace.TableSessionNewDoneInfo) {
+			span := c.start(info.Context, "ydb.table.session.new")
+			return func(info trace.TableSessionNewDoneInfo) {
+				if info.Error == nil && info.Session != nil {
+					finish(span, nil, SessionIDKey.String(info.Session.ID()))
+				} else {
+					finish(span, info.Error)
+				}
+			}
+		}
+		t.OnSessionDelete = func(info trace.TableSessionDeleteStartInfo) func(trace.TableSessionDeleteDoneInfo) {
+			span := c.start(info.Context, "ydb.table.session.delete", SessionIDKey.String(info.Session.ID()))
+			return func(info trace.TableSessionDeleteDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+	}
+	if c.details&trace.TableSessionQueryInvokeEvents != 0 {
+		t.OnSessionQueryPrepare = func(
+			info trace.TablePrepareDataQueryStartInfo,
+		) func(
+			trace.TablePrepareDataQueryDoneInfo,
+		) {
+			span := c.start(info.Context, "ydb.table.session.query.prepare",
+				append(c.queryAttributes(info.Query), SessionIDKey.String(info.Session.ID()))...,
+			)
+			return func(info trace.TablePrepareDataQueryDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+		t.OnSessionQueryExecute = func(
+			info trace.TableExecuteDataQueryStartInfo,
+		) func(
+			trace.TableExecuteDataQueryDoneInfo,
+		) {
+			span := c.start(info.Context, "ydb.table.session.query.execute",
+				append(c.queryAttributes(info.Query.YQL()), SessionIDKey.String(info.Session.ID()))...,
+			)
+			return func(info trace.TableExecuteDataQueryDoneInfo) {
+				if info.Error == nil && info.Tx != nil {
+					finish(span, nil, TxIDKey.String(info.Tx.ID()), attribute.Bool("ydb.query.prepared", info.Prepared))
+				} else {
+					finish(span, info.Error)
+				}
+			}
+		}
+	}
+	if c.details&trace.TableSessionQueryStreamEvents != 0 {
+		t.OnSessionQueryStreamExecute = func(
+			info trace.TableSessionQueryStreamExecuteStartInfo,
+		) func(
+			trace.TableSessionQueryStreamExecuteIntermediateInfo,
+		) func(
+			trace.TableSessionQueryStreamExecuteDoneInfo,
+		) {
+			span := c.start(info.Context, "ydb.table.session.query.stream_execute",
+				append(c.queryAttributes(info.Query.YQL()), SessionIDKey.String(info.Session.ID()))...,
+			)
+			return func(
+				trace.TableSessionQueryStreamExecuteIntermediateInfo,
+			) func(
+				trace.TableSessionQueryStreamExecuteDoneInfo,
+			) {
+				return func(info trace.TableSessionQueryStreamExecuteDoneInfo) {
+					finish(span, info.Error)
+				}
+			}
+		}
+	}
+	if c.details&trace.TableSessionTransactionEvents != 0 {
+		t.OnSessionTransactionBegin = func(
+			info trace.TableSessionTransactionBeginStartInfo,
+		) func(
+			trace.TableSessionTransactionBeginDoneInfo,
+		) {
+			span := c.start(info.Context, "ydb.table.session.tx.begin", SessionIDKey.String(info.Session.ID()))
+			return func(info trace.TableSessionTransactionBeginDoneInfo) {
+				if info.Error == nil && info.Tx != nil {
+					finish(span, nil, TxIDKey.String(info.Tx.ID()))
+				} else {
+					finish(span, info.Error)
+				}
+			}
+		}
+		t.OnSessionTransactionCommit = func(
+			info trace.TableSessionTransactionCommitStartInfo,
+		) func(
+			trace.TableSessionTransactionCommitDoneInfo,
+		) {
+			span := c.start(info.Context, "ydb.table.session.tx.commit",
+				SessionIDKey.String(info.Session.ID()),
+				TxIDKey.String(info.Tx.ID()),
+			)
+			return func(info trace.TableSessionTransactionCommitDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+		t.OnSessionTransactionRollback = func(
+			info trace.TableSessionTransactionRollbackStartInfo,
+		) func(
+			trace.TableSessionTransactionRollbackDoneInfo,
+		) {
+			span := c.start(info.Context, "ydb.table.session.tx.rollback",
+				SessionIDKey.String(info.Session.ID()),
+				TxIDKey.String(info.Tx.ID()),
+			)
+			return func(info trace.TableSessionTransactionRollbackDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+	}
+	return t
+}
+
+// retrySpan is a span of the retry loop with events of failed attempts
+type retrySpan struct {
+	span otelTrace.Span
+	errs []error
+}
+
+func (s *retrySpan) intermediate(err error) {
+	s.errs = append(s.errs, err)
+}
+
+// done adds events of failed attempts and finishes span.
+// The last intermediate call reports the result of the retry loop instead of the attempt
+func (s *retrySpan) done(attempts int, err error) {
+	for i := 0; i < len(s.errs)-1; i++ {
+		if s.errs[i] != nil {
+			s.span.AddEvent("retry", otelTrace.WithAttributes(
+				AttemptKey.Int(i+1),
+				attribute.String("error", s.errs[i].Error()),
+			))
+		}
+	}
+	finish(s.span, err, AttemptsKey.Int(attempts))
+}
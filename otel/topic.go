@@ -0,0 +1,60 @@
+package otel
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Topic makes trace.Topic with spans of reading and committing messages
+func Topic(opts ...Option) trace.Topic {
+	return topic(newConfig(opts...))
+}
+
+func topic(c *config) (t trace.Topic) {
+	if c.details&trace.TopicReaderMessageEvents != 0 {
+		t.OnReaderReadMessages = func(
+			info trace.TopicReaderReadMessagesStartInfo,
+		) func(
+			trace.TopicReaderReadMessagesDoneInfo,
+		) {
+			ctx := info.RequestContext
+			span := c.start(&ctx, "ydb.topic.reader.read_messages")
+			return func(info trace.TopicReaderReadMessagesDoneInfo) {
+				if info.Error != nil {
+					finish(span, info.Error)
+					return
+				}
+				finish(span, nil,
+					TopicKey.String(info.Topic),
+					PartitionIDKey.Int64(info.PartitionID),
+					MessagesKey.Int(info.MessagesCount),
+				)
+			}
+		}
+		t.OnReaderCommit = func(info trace.TopicReaderCommitStartInfo) func(trace.TopicReaderCommitDoneInfo) {
+			ctx := info.RequestContext
+			span := c.start(&ctx, "ydb.topic.reader.commit",
+				TopicKey.String(info.Topic),
+				PartitionIDKey.Int64(info.PartitionID),
+			)
+			return func(info trace.TopicReaderCommitDoneInfo) {
+				finish(span, info.Error)
+			}
+		}
+	}
+	if c.details&trace.TopicWriterStreamLifeCycleEvents != 0 {
+		t.OnWriterInitStream = func(info trace.TopicWriterInitStreamStartInfo) func(trace.TopicWriterInitStreamDoneInfo) {
+			// init of writer stream is not bound to context of application
+			ctx := context.Background()
+			span := c.start(&ctx, "ydb.topic.writer.init_stream",
+				TopicKey.String(info.Topic),
+				ProducerIDKey.String(info.ProducerID),
+			)
+			return func(info trace.TopicWriterInitStreamDoneInfo) {
+				finish(span, info.Error, SessionIDKey.String(info.SessionID))
+			}
+		}
+	}
+	return t
+}
@@ -0,0 +1,100 @@
+// Package budget provides budgets of retry attempts which are shared between many retry loops.
+//
+// Budget prevents retry storms: when YDB is overloaded all retry loops of application
+// consume the same budget and stop retrying when budget is exhausted
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrNoQuota is returned by Budget.Acquire when budget is exhausted
+var ErrNoQuota = xerrors.Wrap(errors.New("retry budget exhausted"))
+
+// Budget limits retry attempts
+type Budget interface {
+	// Acquire takes quota for one retry attempt.
+	// Returns error (ErrNoQuota as usual) if attempt is not allowed
+	Acquire(ctx context.Context) error
+}
+
+type tokenBucket struct {
+	clock clockwork.Clock
+
+	rate  float64 // tokens per second
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+type limitedOption func(b *tokenBucket)
+
+// WithBurst sets maximum count of attempts which can be acquired at once.
+// Default burst is equal to attempts per second
+func WithBurst(burst int) limitedOption {
+	return func(b *tokenBucket) {
+		if burst > 0 {
+			b.burst = float64(burst)
+		}
+	}
+}
+
+func withClock(clock clockwork.Clock) limitedOption {
+	return func(b *tokenBucket) {
+		b.clock = clock
+	}
+}
+
+// Limited makes token bucket budget which allows attemptsPerSecond retry attempts per second.
+// Budget is safe for concurrent use and must be shared between retry loops
+func Limited(attemptsPerSecond float64, opts ...limitedOption) Budget {
+	b := &tokenBucket{
+		clock: clockwork.NewRealClock(),
+		rate:  attemptsPerSecond,
+		burst: attemptsPerSecond,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(b)
+		}
+	}
+	if b.burst < 1 {
+		b.burst = 1
+	}
+	b.tokens = b.burst
+	b.last = b.clock.Now()
+	return b
+}
+
+func (b *tokenBucket) Acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w (%g attempts per second)", ErrNoQuota, b.rate))
+	}
+	b.tokens--
+
+	return nil
+}
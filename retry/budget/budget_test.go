@@ -0,0 +1,40 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimited(t *testing.T) {
+	ctx := context.Background()
+	clock := clockwork.NewFakeClock()
+	b := Limited(2, WithBurst(3), withClock(clock))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.Acquire(ctx))
+	}
+	err := b.Acquire(ctx)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrNoQuota))
+
+	clock.Advance(500 * time.Millisecond)
+	require.NoError(t, b.Acquire(ctx))
+	require.Error(t, b.Acquire(ctx))
+
+	// tokens are limited by burst after long idle
+	clock.Advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.Acquire(ctx))
+	}
+	require.Error(t, b.Acquire(ctx))
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	clock.Advance(time.Hour)
+	require.ErrorIs(t, b.Acquire(cancelledCtx), context.Canceled)
+}
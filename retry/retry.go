@@ -8,6 +8,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/wait"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -23,6 +24,7 @@ type retryOptions struct {
 	stackTrace  bool
 	fastBackoff backoff.Backoff
 	slowBackoff backoff.Backoff
+	budget      budget.Budget
 
 	panicCallback func(e interface{})
 }
@@ -75,6 +77,15 @@ func WithSlowBackoff(b backoff.Backoff) retryOption {
 	}
 }
 
+// WithBudget limits retry attempts with budget which can be shared between many Retry calls.
+// First attempt of operation is not limited by budget.
+// If budget is exhausted Retry returns last error of operation without next attempts
+func WithBudget(b budget.Budget) retryOption {
+	return func(o *retryOptions) {
+		o.budget = b
+	}
+}
+
 // WithPanicCallback returns panic callback option
 // If not defined - panic would not intercept with driver
 func WithPanicCallback(panicCallback func(e interface{})) retryOption {
@@ -193,6 +204,19 @@ func Retry(ctx context.Context, op retryOperation, opts ...retryOption) (err err
 				)
 			}
 
+			if options.budget != nil {
+				if e := options.budget.Acquire(ctx); e != nil {
+					trace.RetryOnBudgetExhausted(options.trace, &ctx, options.id, attempts, err)
+					return xerrors.WithStackTrace(
+						xerrors.Join(
+							fmt.Errorf("retry budget exhausted on attempt No.%d",
+								attempts,
+							), e, err,
+						),
+					)
+				}
+			}
+
 			if e := wait.Wait(ctx, options.fastBackoff, options.slowBackoff, m.BackoffType(), i); e != nil {
 				return xerrors.WithStackTrace(
 					xerrors.Join(
@@ -11,8 +11,11 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestRetryModes(t *testing.T) {
@@ -180,3 +183,31 @@ func TestRetryTransportCancelled(t *testing.T) {
 		require.Equal(t, cancelCounterValue, counter)
 	}
 }
+
+func TestRetryWithBudget(t *testing.T) {
+	var (
+		b         = budget.Limited(1, budget.WithBurst(2))
+		counter   = 0
+		exhausted = 0
+	)
+	err := Retry(context.Background(), func(ctx context.Context) (err error) {
+		counter++
+		return xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+	},
+		WithIdempotent(true),
+		WithBudget(b),
+		WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+		WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+		WithTrace(trace.Retry{
+			OnBudgetExhausted: func(info trace.RetryBudgetExhaustedInfo) {
+				exhausted++
+				require.Equal(t, 3, info.Attempts)
+				require.Error(t, info.Error)
+			},
+		}),
+	)
+	require.ErrorIs(t, err, budget.ErrNoQuota)
+	require.True(t, xerrors.IsTransportError(err, grpcCodes.Unavailable))
+	require.Equal(t, 3, counter)
+	require.Equal(t, 1, exhausted)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 )
 
 type doOptions struct {
@@ -23,6 +24,15 @@ func WithDoRetryOptions(opts ...retryOption) doOption {
 	}
 }
 
+// retryBudget returns budget of retry attempts from ydb connector (see ydb.WithRetryBudget)
+// if db opened with sql.OpenDB(connector)
+func retryBudget(db *sql.DB) budget.Budget {
+	if d, ok := db.Driver().(interface{ RetryBudget() budget.Budget }); ok {
+		return d.RetryBudget()
+	}
+	return nil
+}
+
 // Do is a retryer of database/sql Conn with fallbacks on errors
 func Do(ctx context.Context, db *sql.DB, f func(ctx context.Context, cc *sql.Conn) error, opts ...doOption) error {
 	var (
@@ -49,7 +59,7 @@ func Do(ctx context.Context, db *sql.DB, f func(ctx context.Context, cc *sql.Con
 			return unwrapErrBadConn(xerrors.WithStackTrace(err))
 		}
 		return nil
-	}, options.retryOptions...)
+	}, append([]retryOption{WithBudget(retryBudget(db))}, options.retryOptions...)...)
 	if err != nil {
 		return xerrors.WithStackTrace(
 			fmt.Errorf("operation failed with %d attempts: %w", attempts, err),
@@ -126,7 +136,7 @@ func DoTx(ctx context.Context, db *sql.DB, f func(context.Context, *sql.Tx) erro
 			return unwrapErrBadConn(xerrors.WithStackTrace(err))
 		}
 		return nil
-	}, options.retryOptions...)
+	}, append([]retryOption{WithBudget(retryBudget(db))}, options.retryOptions...)...)
 	if err != nil {
 		return xerrors.WithStackTrace(
 			fmt.Errorf("tx operation failed with %d attempts: %w", attempts, err),
@@ -160,7 +160,10 @@ func Connector(parent *Driver, opts ...ConnectorOption) (SQLConnector, error) {
 	c, err := xsql.Open(parent,
 		append(
 			append(
-				parent.databaseSQLOptions,
+				append(
+					[]ConnectorOption{xsql.WithRetryBudget(parent.config.RetryBudget())},
+					parent.databaseSQLOptions...,
+				),
 				opts...,
 			),
 			xsql.WithOnClose(d.detach),
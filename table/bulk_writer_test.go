@@ -0,0 +1,103 @@
+package table_test
+
+import (
+	"context"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil/fakeydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestBulkWriter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv := fakeydb.New()
+	defer srv.Close()
+
+	var flushes, flushedRows int64
+	db, err := srv.Open(ctx, ydb.WithTraceTable(trace.Table{
+		OnBulkWriterFlush: func(info trace.TableBulkWriterFlushStartInfo) func(trace.TableBulkWriterFlushDoneInfo) {
+			atomic.AddInt64(&flushes, 1)
+			atomic.AddInt64(&flushedRows, int64(info.Rows))
+			return nil
+		},
+	}))
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, `CREATE TABLE series (id Uint64 NOT NULL, title Text, PRIMARY KEY (id))`)
+	})
+	require.NoError(t, err)
+
+	w, err := db.Table().BulkWriter(ctx, path.Join(db.Name(), "series"),
+		options.WithBulkWriterMaxBatchRows(100),
+		options.WithBulkWriterConcurrency(2),
+		options.WithBulkWriterFlushInterval(time.Hour),
+		options.WithBulkWriterShardByKeyBounds(),
+	)
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 250; i++ {
+		err = w.Write(ctx, types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(i)),
+			types.StructFieldValue("title", types.TextValue("title")),
+		))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Flush(ctx))
+	require.Equal(t, int64(3), atomic.LoadInt64(&flushes))
+	require.Equal(t, int64(250), atomic.LoadInt64(&flushedRows))
+
+	require.NoError(t, w.Close(ctx))
+	require.Error(t, w.Write(ctx, types.StructValue(
+		types.StructFieldValue("id", types.Uint64Value(0)),
+	)))
+
+	// non-positive max batch rows means default max batch rows
+	atomic.StoreInt64(&flushes, 0)
+	w, err = db.Table().BulkWriter(ctx, path.Join(db.Name(), "series"),
+		options.WithBulkWriterMaxBatchRows(0),
+		options.WithBulkWriterFlushInterval(time.Hour),
+	)
+	require.NoError(t, err)
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, w.Write(ctx, types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(i)),
+			types.StructFieldValue("title", types.TextValue("title")),
+		)))
+	}
+	require.NoError(t, w.Close(ctx))
+	require.Equal(t, int64(1), atomic.LoadInt64(&flushes))
+
+	var rows int
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, table.DefaultTxControl(), `SELECT id FROM series`, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		for rows = 0; res.NextResultSet(ctx); {
+			for res.NextRow() {
+				rows++
+			}
+		}
+		return res.Err()
+	})
+	require.NoError(t, err)
+	require.Equal(t, 250, rows)
+}
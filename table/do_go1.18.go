@@ -0,0 +1,39 @@
+//go:build go1.18
+// +build go1.18
+
+package table
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/hedging"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// DoWithResult calls op in session from c with retries through c.Do and returns result of successful op call.
+//
+// Result of op is handed off by SDK, so op must not save it to outer variables. With WithHedging option
+// attempts of op call concurrently and only result of the first successful attempt returns
+func DoWithResult[T any](
+	ctx context.Context, c Client, op func(ctx context.Context, s Session) (T, error), opts ...Option,
+) (result T, _ error) {
+	err := c.Do(hedging.WithResultHandOff(ctx), func(ctx context.Context, s Session) error {
+		r, err := op(ctx, s)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		hedging.Save(ctx, func() {
+			result = r
+		})
+
+		return nil
+	}, opts...)
+	if err != nil {
+		var zero T
+
+		return zero, xerrors.WithStackTrace(err)
+	}
+
+	return result, nil
+}
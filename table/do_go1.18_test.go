@@ -0,0 +1,48 @@
+//go:build go1.18
+// +build go1.18
+
+package table_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil/fakeydb"
+)
+
+func TestDoWithResult(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv := fakeydb.New()
+	defer srv.Close()
+
+	db, err := srv.Open(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	t.Run("Hedging", func(t *testing.T) {
+		for i := 0; i < 32; i++ {
+			id, err := table.DoWithResult(ctx, db.Table(), func(ctx context.Context, s table.Session) (string, error) {
+				return s.ID(), nil
+			}, table.WithIdempotent(), table.WithHedging(50))
+			require.NoError(t, err)
+			require.NotEmpty(t, id)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		errTest := errors.New("test")
+		id, err := table.DoWithResult(ctx, db.Table(), func(ctx context.Context, s table.Session) (string, error) {
+			return s.ID(), errTest
+		})
+		require.ErrorIs(t, err, errTest)
+		require.Empty(t, id)
+	})
+}
@@ -560,18 +560,22 @@ func (unit *TimeToLiveUnit) ToYDB() Ydb_Table.ValueSinceUnixEpochModeSettings_Un
 }
 
 type ChangefeedDescription struct {
-	Name   string
-	Mode   ChangefeedMode
-	Format ChangefeedFormat
-	State  ChangefeedState
+	Name              string
+	Mode              ChangefeedMode
+	Format            ChangefeedFormat
+	State             ChangefeedState
+	VirtualTimestamps bool
+	Attributes        map[string]string
 }
 
 func NewChangefeedDescription(proto *Ydb_Table.ChangefeedDescription) ChangefeedDescription {
 	return ChangefeedDescription{
-		Name:   proto.GetName(),
-		Mode:   ChangefeedMode(proto.GetMode()),
-		Format: ChangefeedFormat(proto.GetFormat()),
-		State:  ChangefeedState(proto.GetState()),
+		Name:              proto.GetName(),
+		Mode:              ChangefeedMode(proto.GetMode()),
+		Format:            ChangefeedFormat(proto.GetFormat()),
+		State:             ChangefeedState(proto.GetState()),
+		VirtualTimestamps: proto.GetVirtualTimestamps(),
+		Attributes:        proto.GetAttributes(),
 	}
 }
 
@@ -585,6 +589,10 @@ const (
 
 type ChangefeedMode int
 
+func (mode ChangefeedMode) ApplyChangefeedOption(d *changefeedDesc) {
+	d.Mode = Ydb_Table.ChangefeedMode_Mode(mode)
+}
+
 const (
 	ChangefeedModeUnspecified     = ChangefeedMode(Ydb_Table.ChangefeedMode_MODE_UNSPECIFIED)
 	ChangefeedModeKeysOnly        = ChangefeedMode(Ydb_Table.ChangefeedMode_MODE_KEYS_ONLY)
@@ -596,6 +604,10 @@ const (
 
 type ChangefeedFormat int
 
+func (format ChangefeedFormat) ApplyChangefeedOption(d *changefeedDesc) {
+	d.Format = Ydb_Table.ChangefeedFormat_Format(format)
+}
+
 const (
 	ChangefeedFormatUnspecified         = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_UNSPECIFIED)
 	ChangefeedFormatJSON                = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_JSON)
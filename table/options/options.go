@@ -1,9 +1,12 @@
 package options
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
@@ -224,6 +227,113 @@ func WithIndexType(t IndexType) IndexOption {
 	return t
 }
 
+type (
+	changefeedDesc   Ydb_Table.Changefeed
+	ChangefeedOption interface {
+		ApplyChangefeedOption(d *changefeedDesc)
+	}
+)
+
+type changefeed struct {
+	name string
+	opts []ChangefeedOption
+}
+
+func (cf changefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	x := &Ydb_Table.Changefeed{
+		Name: cf.name,
+	}
+	for _, opt := range cf.opts {
+		if opt != nil {
+			opt.ApplyChangefeedOption((*changefeedDesc)(x))
+		}
+	}
+	d.AddChangefeeds = append(d.AddChangefeeds, x)
+}
+
+// WithAddChangefeed adds changefeed with name in AlterTable request.
+// Mode and format of changefeed are required and defined by WithChangefeedMode and WithChangefeedFormat
+func WithAddChangefeed(name string, opts ...ChangefeedOption) AlterTableOption {
+	return changefeed{
+		name: name,
+		opts: opts,
+	}
+}
+
+type dropChangefeed string
+
+func (name dropChangefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.DropChangefeeds = append(d.DropChangefeeds, string(name))
+}
+
+// WithDropChangefeed drops changefeed with name in AlterTable request
+func WithDropChangefeed(name string) AlterTableOption {
+	return dropChangefeed(name)
+}
+
+// WithChangefeedMode defines the information that will be written to the changefeed
+func WithChangefeedMode(mode ChangefeedMode) ChangefeedOption {
+	return mode
+}
+
+// WithChangefeedFormat defines the format of changefeed records
+func WithChangefeedFormat(format ChangefeedFormat) ChangefeedOption {
+	return format
+}
+
+type changefeedRetentionPeriod time.Duration
+
+func (period changefeedRetentionPeriod) ApplyChangefeedOption(d *changefeedDesc) {
+	d.RetentionPeriod = durationpb.New(time.Duration(period))
+}
+
+// WithChangefeedRetentionPeriod defines how long records are stored in the topic of changefeed
+func WithChangefeedRetentionPeriod(period time.Duration) ChangefeedOption {
+	return changefeedRetentionPeriod(period)
+}
+
+type changefeedVirtualTimestamps bool
+
+func (virtualTimestamps changefeedVirtualTimestamps) ApplyChangefeedOption(d *changefeedDesc) {
+	d.VirtualTimestamps = bool(virtualTimestamps)
+}
+
+// WithChangefeedVirtualTimestamps enables virtual timestamps of changes in changefeed records
+func WithChangefeedVirtualTimestamps() ChangefeedOption {
+	return changefeedVirtualTimestamps(true)
+}
+
+type changefeedInitialScan bool
+
+func (initialScan changefeedInitialScan) ApplyChangefeedOption(d *changefeedDesc) {
+	d.InitialScan = bool(initialScan)
+}
+
+// WithChangefeedInitialScan makes changefeed output the current state of the table first
+func WithChangefeedInitialScan() ChangefeedOption {
+	return changefeedInitialScan(true)
+}
+
+type changefeedAttribute struct {
+	key   string
+	value string
+}
+
+func (a changefeedAttribute) ApplyChangefeedOption(d *changefeedDesc) {
+	if d.Attributes == nil {
+		d.Attributes = make(map[string]string)
+	}
+	d.Attributes[a.key] = a.value
+}
+
+// WithChangefeedAttribute adds attribute to changefeed
+func WithChangefeedAttribute(key, value string) ChangefeedOption {
+	return changefeedAttribute{
+		key:   key,
+		value: value,
+	}
+}
+
 type columnFamilies []ColumnFamily
 
 func (cf columnFamilies) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
@@ -1136,3 +1246,178 @@ func (d *ReadTableDesc) initKeyRange() {
 		d.KeyRange = new(Ydb_Table.KeyRange)
 	}
 }
+
+type (
+	// ScanStructSettings contains settings of result.BaseResult.ScanStruct
+	ScanStructSettings struct {
+		// TagName is a name of struct field tag with column name.
+		// If empty, tags "ydb" and "sql" are checked in this order.
+		TagName                       string
+		AllowMissingColumnsFromSelect bool
+		AllowMissingFieldsInStruct    bool
+	}
+	ScanStructOption interface {
+		ApplyScanStructOption(settings *ScanStructSettings)
+	}
+	scanStructOptionFunc func(settings *ScanStructSettings)
+)
+
+func (f scanStructOptionFunc) ApplyScanStructOption(settings *ScanStructSettings) {
+	f(settings)
+}
+
+var _ ScanStructOption = scanStructOptionFunc(nil)
+
+// WithScanStructTagName defines struct field tag name with column name
+func WithScanStructTagName(name string) ScanStructOption {
+	return scanStructOptionFunc(func(settings *ScanStructSettings) {
+		settings.TagName = name
+	})
+}
+
+// WithScanStructAllowMissingColumnsFromSelect allows struct fields which have no columns in the result set
+func WithScanStructAllowMissingColumnsFromSelect() ScanStructOption {
+	return scanStructOptionFunc(func(settings *ScanStructSettings) {
+		settings.AllowMissingColumnsFromSelect = true
+	})
+}
+
+// WithScanStructAllowMissingFieldsInStruct allows columns of the result set which have no fields in the struct
+func WithScanStructAllowMissingFieldsInStruct() ScanStructOption {
+	return scanStructOptionFunc(func(settings *ScanStructSettings) {
+		settings.AllowMissingFieldsInStruct = true
+	})
+}
+
+type (
+	// BulkWriterSettings contains settings of table.BulkWriter
+	BulkWriterSettings struct {
+		// MaxBatchRows is a max count of rows in one BulkUpsert call
+		MaxBatchRows int
+		// MaxBatchBytes is a max size of rows in one BulkUpsert call
+		MaxBatchBytes int
+		// FlushInterval is a max time of rows buffering before BulkUpsert call
+		FlushInterval time.Duration
+		// Concurrency is a max count of concurrent BulkUpsert calls
+		Concurrency int
+		// ShardByKeyBounds enables grouping of rows into batches by table partitions
+		ShardByKeyBounds bool
+	}
+	BulkWriterOption interface {
+		ApplyBulkWriterOption(settings *BulkWriterSettings)
+	}
+	bulkWriterOptionFunc func(settings *BulkWriterSettings)
+)
+
+func (f bulkWriterOptionFunc) ApplyBulkWriterOption(settings *BulkWriterSettings) {
+	f(settings)
+}
+
+var _ BulkWriterOption = bulkWriterOptionFunc(nil)
+
+// WithBulkWriterMaxBatchRows defines max count of rows in one BulkUpsert call.
+// Non-positive value means default max count of rows
+func WithBulkWriterMaxBatchRows(rows int) BulkWriterOption {
+	return bulkWriterOptionFunc(func(settings *BulkWriterSettings) {
+		settings.MaxBatchRows = rows
+	})
+}
+
+// WithBulkWriterMaxBatchBytes defines max size of rows (in bytes of protobuf representation)
+// in one BulkUpsert call
+func WithBulkWriterMaxBatchBytes(bytes int) BulkWriterOption {
+	return bulkWriterOptionFunc(func(settings *BulkWriterSettings) {
+		settings.MaxBatchBytes = bytes
+	})
+}
+
+// WithBulkWriterFlushInterval defines max time of rows buffering before BulkUpsert call
+func WithBulkWriterFlushInterval(interval time.Duration) BulkWriterOption {
+	return bulkWriterOptionFunc(func(settings *BulkWriterSettings) {
+		settings.FlushInterval = interval
+	})
+}
+
+// WithBulkWriterConcurrency defines max count of concurrent BulkUpsert calls.
+// Write blocks while all BulkUpsert calls are in flight
+func WithBulkWriterConcurrency(concurrency int) BulkWriterOption {
+	return bulkWriterOptionFunc(func(settings *BulkWriterSettings) {
+		settings.Concurrency = concurrency
+	})
+}
+
+// WithBulkWriterShardByKeyBounds enables grouping of rows into batches by table partitions.
+// Partitions boundaries are requested with DescribeTable on BulkWriter creation.
+// Rows must be struct values with all primary key columns
+func WithBulkWriterShardByKeyBounds() BulkWriterOption {
+	return bulkWriterOptionFunc(func(settings *BulkWriterSettings) {
+		settings.ShardByKeyBounds = true
+	})
+}
+
+type (
+	// ReadTableParallelSettings contains settings of parallel read of table
+	ReadTableParallelSettings struct {
+		// Concurrency is a max count of concurrent StreamReadTable calls
+		Concurrency int
+		// ReadTableOptions are options of each StreamReadTable call
+		ReadTableOptions []ReadTableOption
+	}
+	ReadTableParallelOption interface {
+		ApplyReadTableParallelOption(settings *ReadTableParallelSettings)
+	}
+	readTableParallelOptionFunc func(settings *ReadTableParallelSettings)
+)
+
+func (f readTableParallelOptionFunc) ApplyReadTableParallelOption(settings *ReadTableParallelSettings) {
+	f(settings)
+}
+
+var _ ReadTableParallelOption = readTableParallelOptionFunc(nil)
+
+// WithReadTableParallelConcurrency defines max count of concurrent StreamReadTable calls
+func WithReadTableParallelConcurrency(concurrency int) ReadTableParallelOption {
+	return readTableParallelOptionFunc(func(settings *ReadTableParallelSettings) {
+		settings.Concurrency = concurrency
+	})
+}
+
+// WithReadTableParallelReadOptions defines options of each StreamReadTable call.
+// Key range options are ignored because key ranges are defined by table partitions.
+// Read is always ordered, row limit applies to each partition
+func WithReadTableParallelReadOptions(opts ...ReadTableOption) ReadTableParallelOption {
+	return readTableParallelOptionFunc(func(settings *ReadTableParallelSettings) {
+		settings.ReadTableOptions = append(settings.ReadTableOptions, opts...)
+	})
+}
+
+// ResumableStreamOption is an option of StreamReadTable and StreamExecuteScanQuery which enables
+// transparent reopening of stream after retryable errors
+type ResumableStreamOption struct{}
+
+var (
+	_ ReadTableOption        = ResumableStreamOption{}
+	_ ExecuteScanQueryOption = ResumableStreamOption{}
+)
+
+// ApplyReadTableOption makes read ordered by primary key because read resumes after the last delivered key
+func (ResumableStreamOption) ApplyReadTableOption(desc *ReadTableDesc, a *allocator.Allocator) {
+	desc.Ordered = true
+}
+
+func (ResumableStreamOption) ApplyExecuteScanQueryOption(desc *ExecuteScanQueryDesc) []grpc.CallOption {
+	return nil
+}
+
+// WithResumableStream returns option which makes stream of StreamReadTable or StreamExecuteScanQuery
+// resumable after retryable errors, including transport errors.
+// Stream reopens on the same session or on new session if session of stream is broken.
+//
+// StreamReadTable reopens with read of rows greater than primary key of the last delivered row,
+// read becomes ordered. Primary key columns are appended to read columns if they are not selected.
+//
+// StreamExecuteScanQuery re-executes query only if no rows are delivered yet, because re-executed query
+// reads new snapshot of data and cannot continue exactly after the last delivered row
+func WithResumableStream() ResumableStreamOption {
+	return ResumableStreamOption{}
+}
@@ -2,6 +2,7 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -251,4 +252,31 @@ func TestAlterTableOptions(t *testing.T) {
 			t.Errorf("Alter table storage settings options is not as expected")
 		}
 	}
+	{
+		opt := WithAddChangefeed("feed",
+			WithChangefeedMode(ChangefeedModeNewAndOldImages),
+			WithChangefeedFormat(ChangefeedFormatJSON),
+			WithChangefeedRetentionPeriod(24*time.Hour),
+			WithChangefeedVirtualTimestamps(),
+			WithChangefeedInitialScan(),
+			WithChangefeedAttribute("key", "value"),
+		)
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Len(t, req.GetAddChangefeeds(), 1)
+		cf := req.GetAddChangefeeds()[0]
+		require.Equal(t, "feed", cf.GetName())
+		require.Equal(t, Ydb_Table.ChangefeedMode_MODE_NEW_AND_OLD_IMAGES, cf.GetMode())
+		require.Equal(t, Ydb_Table.ChangefeedFormat_FORMAT_JSON, cf.GetFormat())
+		require.Equal(t, 24*time.Hour, cf.GetRetentionPeriod().AsDuration())
+		require.True(t, cf.GetVirtualTimestamps())
+		require.True(t, cf.GetInitialScan())
+		require.Equal(t, map[string]string{"key": "value"}, cf.GetAttributes())
+	}
+	{
+		opt := WithDropChangefeed("feed")
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Equal(t, []string{"feed"}, req.GetDropChangefeeds())
+	}
 }
@@ -0,0 +1,159 @@
+//go:build go1.18
+// +build go1.18
+
+package table
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	sqlScannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	ydbScannerType = reflect.TypeOf((*types.Scanner)(nil)).Elem()
+)
+
+// QueryRowsOption is an option of QueryRows
+type QueryRowsOption func(o *queryRowsOptions)
+
+type queryRowsOptions struct {
+	txControl   *TransactionControl
+	executeOpts []options.ExecuteDataQueryOption
+	doOpts      []Option
+}
+
+// WithQueryRowsTxControl defines transaction control of query. DefaultTxControl is used by default
+func WithQueryRowsTxControl(txControl *TransactionControl) QueryRowsOption {
+	return func(o *queryRowsOptions) {
+		o.txControl = txControl
+	}
+}
+
+// WithQueryRowsExecuteOptions appends options of query execution
+func WithQueryRowsExecuteOptions(opts ...options.ExecuteDataQueryOption) QueryRowsOption {
+	return func(o *queryRowsOptions) {
+		o.executeOpts = append(o.executeOpts, opts...)
+	}
+}
+
+// WithQueryRowsDoOptions appends options of retries through Client.Do
+func WithQueryRowsDoOptions(opts ...Option) QueryRowsOption {
+	return func(o *queryRowsOptions) {
+		o.doOpts = append(o.doOpts, opts...)
+	}
+}
+
+// QueryRows executes data query with params and scans all rows of all result sets into slice of T.
+//
+// If T is a struct - row scans with ScanStruct by struct tags, otherwise row must have single column
+// which scans into T. Structs which are scan targets themselves (time.Time, sql.Scanner and
+// types.Scanner implementations) are scanned as single column.
+// Query executes in session from c with retries through c.Do. Transaction control, execute options
+// and options of c.Do are defined by opts
+func QueryRows[T any](
+	ctx context.Context, c Client, query string, params *QueryParameters, opts ...QueryRowsOption,
+) (rows []T, _ error) {
+	o := queryRowsOptions{
+		txControl: DefaultTxControl(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	err := c.Do(ctx, func(ctx context.Context, s Session) (err error) {
+		_, res, err := s.Execute(ctx, o.txControl, query, params, o.executeOpts...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+
+		rows = rows[:0]
+
+		return scanRows(ctx, res, func(row T) error {
+			rows = append(rows, row)
+			return nil
+		})
+	}, o.doOpts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return rows, nil
+}
+
+// StreamQueryRows executes scan query with params and calls f for each row scanned into T
+// while rows are streamed from server.
+//
+// T scans same as in QueryRows. Query executes in session from c with retries through c.Do,
+// opts are passed to c.Do. If f returns error - streaming stops and error returns.
+//
+// Warning: on retry rows are streamed from the beginning, so f can be called with same rows again
+func StreamQueryRows[T any](
+	ctx context.Context, c Client, query string, params *QueryParameters, f func(row T) error, opts ...Option,
+) error {
+	err := c.Do(ctx, func(ctx context.Context, s Session) (err error) {
+		res, err := s.StreamExecuteScanQuery(ctx, query, params)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+
+		return scanRows(ctx, res, f)
+	}, opts...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func scanRows[T any](ctx context.Context, res result.BaseResult, f func(row T) error) error {
+	isStruct := isStructRow(reflect.TypeOf((*T)(nil)).Elem())
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			var (
+				row T
+				err error
+			)
+			if isStruct {
+				err = res.ScanStruct(&row)
+			} else {
+				err = res.Scan(&row)
+			}
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if err = f(row); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+	if err := res.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// isStructRow reports that row must be scanned into t with ScanStruct:
+// t is a struct which is not a scan target of single column itself
+func isStructRow(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t == timeType {
+		return false
+	}
+	ptr := reflect.PtrTo(t)
+	return !ptr.Implements(sqlScannerType) && !ptr.Implements(ydbScannerType)
+}
@@ -0,0 +1,103 @@
+//go:build go1.18
+// +build go1.18
+
+package table_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil/fakeydb"
+)
+
+func TestQueryRows(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv := fakeydb.New()
+	defer srv.Close()
+
+	db, err := srv.Open(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		err := s.ExecuteSchemeQuery(ctx, `CREATE TABLE series (id Uint64 NOT NULL, title Text, PRIMARY KEY (id))`)
+		if err != nil {
+			return err
+		}
+		_, _, err = s.Execute(ctx, table.DefaultTxControl(), `
+			UPSERT INTO series (id, title) VALUES (1, "IT Crowd"), (2, "Silicon Valley");
+		`, nil)
+		return err
+	})
+	require.NoError(t, err)
+
+	type series struct {
+		ID    uint64  `ydb:"id"`
+		Title *string `ydb:"title"`
+	}
+
+	t.Run("Struct", func(t *testing.T) {
+		rows, err := table.QueryRows[series](ctx, db.Table(), `SELECT id, title FROM series ORDER BY id`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, uint64(1), rows[0].ID)
+		require.Equal(t, "Silicon Valley", *rows[1].Title)
+	})
+	t.Run("SingleColumn", func(t *testing.T) {
+		ids, err := table.QueryRows[uint64](ctx, db.Table(), `SELECT id FROM series ORDER BY id`, nil)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2}, ids)
+	})
+	t.Run("Options", func(t *testing.T) {
+		ids, err := table.QueryRows[uint64](ctx, db.Table(), `SELECT id FROM series ORDER BY id`, nil,
+			table.WithQueryRowsTxControl(table.OnlineReadOnlyTxControl()),
+			table.WithQueryRowsExecuteOptions(options.WithKeepInCache(false)),
+			table.WithQueryRowsDoOptions(table.WithIdempotent()),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2}, ids)
+	})
+	t.Run("SingleTimestampColumn", func(t *testing.T) {
+		ts := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+		err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			err := s.ExecuteSchemeQuery(ctx,
+				`CREATE TABLE events (id Uint64 NOT NULL, ts Timestamp NOT NULL, PRIMARY KEY (id))`,
+			)
+			if err != nil {
+				return err
+			}
+			_, _, err = s.Execute(ctx, table.DefaultTxControl(), `
+				DECLARE $ts AS Timestamp;
+				UPSERT INTO events (id, ts) VALUES (1, $ts);
+			`, table.NewQueryParameters(table.ValueParam("$ts", types.TimestampValueFromTime(ts))))
+			return err
+		})
+		require.NoError(t, err)
+
+		rows, err := table.QueryRows[time.Time](ctx, db.Table(), `SELECT ts FROM events`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.True(t, ts.Equal(rows[0]))
+	})
+	t.Run("Stream", func(t *testing.T) {
+		var ids []uint64
+		err := table.StreamQueryRows(ctx, db.Table(), `SELECT id, title FROM series ORDER BY id`, nil,
+			func(row series) error {
+				ids = append(ids, row.ID)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2}, ids)
+	})
+}
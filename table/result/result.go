@@ -3,6 +3,7 @@ package result
 import (
 	"context"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
@@ -105,6 +106,14 @@ type BaseResult interface {
 	// ScanNamed scans row with column names defined in namedValues
 	ScanNamed(namedValues ...named.Value) error
 
+	// ScanStruct scans row into the struct pointed by dst.
+	// Columns are mapped to exported struct fields by tags "ydb" or "sql" (or the field name if there is no tag).
+	// If there is no exact match, column names are matched case-insensitively.
+	// Fields with tag "-" are skipped, fields of embedded structs are mapped as fields of dst.
+	// Optional columns are scanned into pointer fields (nil for NULL) or into value fields (zero value for NULL).
+	// By default columns without struct fields and struct fields without columns are reported as error.
+	ScanStruct(dst interface{}, opts ...options.ScanStructOption) error
+
 	// Stats returns query execution QueryStats.
 	//
 	// If query result have no stats - returns nil
@@ -2,6 +2,8 @@ package table
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"sort"
 	"time"
 
@@ -11,7 +13,9 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -67,6 +71,79 @@ type Client interface {
 	// If op TxOperation return non nil - transaction will be rollback
 	// Warning: if context without deadline or cancellation func than DoTx can run indefinitely
 	DoTx(ctx context.Context, op TxOperation, opts ...Option) error
+
+	// Stats returns snapshot of sessions pool state
+	Stats() PoolStats
+
+	// SetLimit changes upper bound of sessions count in pool at runtime.
+	//
+	// If pool shrinks - excess idle sessions closes immediately and excess busy sessions
+	// closes on return to pool. Non-positive limit is ignored
+	SetLimit(limit int)
+
+	// BulkWriter returns writer which uploads rows to the table at path with BulkUpsert calls.
+	//
+	// BulkWriter groups rows into batches and makes concurrent BulkUpsert calls with retries
+	// on sessions from pool. BulkWriter must be closed after use
+	BulkWriter(ctx context.Context, path string, opts ...options.BulkWriterOption) (BulkWriter, error)
+
+	// ReadTableParallel reads table at path with concurrent StreamReadTable calls over table partitions.
+	//
+	// Partitions are defined by shard key bounds from DescribeTable. ReadTableParallel calls f with
+	// chunks of rows of each partition in order of partition key, chunks of different partitions
+	// are passed to f concurrently. Read of partition resumes from the last read key after
+	// retryable error. Read columns always includes primary key columns.
+	// If f returns error - all reads stops and ReadTableParallel returns error
+	ReadTableParallel(
+		ctx context.Context,
+		path string,
+		f func(ctx context.Context, partition int, res result.Result) error,
+		opts ...options.ReadTableParallelOption,
+	) error
+}
+
+// BulkWriter uploads rows to table with batched BulkUpsert calls.
+//
+// BulkWriter becomes unusable after first failed BulkUpsert call (after retries): rows of failed batch
+// and not uploaded rows are lost, all next Write and Flush calls returns error of failed call.
+// Failed BulkWriter must be closed and new BulkWriter must be created for continue upload
+type BulkWriter interface {
+	// Write appends rows to batches. Rows must be struct values.
+	//
+	// Write blocks while max count of BulkUpsert calls are in flight.
+	// Write returns error of failed BulkUpsert call if any occurred
+	Write(ctx context.Context, rows ...types.Value) error
+
+	// Flush uploads all written rows and waits for done of all BulkUpsert calls.
+	// Flush returns error of failed BulkUpsert call if any occurred
+	Flush(ctx context.Context) error
+
+	// Close flushes written rows and releases resources of BulkWriter
+	Close(ctx context.Context) error
+}
+
+// PoolStats is a snapshot of sessions pool state
+type PoolStats struct {
+	// Limit is an upper bound of sessions count in pool
+	Limit int
+
+	// Index is a count of all sessions in pool
+	Index int
+
+	// Idle is a count of sessions which are ready for use
+	Idle int
+
+	// Busy is a count of sessions which are in use
+	Busy int
+
+	// CreateInProgress is a count of sessions which are creating now
+	CreateInProgress int
+
+	// Waiters is a count of callers which are waiting for session because pool is full
+	Waiters int
+
+	// CreateFailures is a count of failed session creations since pool start
+	CreateFailures uint64
 }
 
 type SessionStatus = string
@@ -552,13 +629,56 @@ func ValueParam(name string, v types.Value) ParameterOption {
 	}
 }
 
+// StructParams makes query parameters from exported fields of struct (or pointer to struct) v.
+//
+// Parameter name is "$" + field name from "ydb" or "sql" tag (or Go field name if tag is absent).
+// Fields with tag "-" are skipped. Field types converts to YDB types same as database/sql query args.
+// Nested structs converts to Struct values, slices converts to List values.
+// For example, slice of structs field can be used for batch upsert with AS_TABLE:
+//
+//	type batch struct {
+//		Series []series `ydb:"series"`
+//	}
+//	params, err := table.StructParams(batch{Series: series})
+//	...
+//	UPSERT INTO series SELECT * FROM AS_TABLE($series);
+func StructParams(v interface{}) (*QueryParameters, error) {
+	fields, err := params.Fields(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	opts := make([]ParameterOption, 0, len(fields))
+	for _, f := range fields {
+		opts = append(opts, ValueParam(f.Name, f.Value))
+	}
+	return NewQueryParameters(opts...), nil
+}
+
+// StructListValue makes List<Struct<...>> value from slice of structs v.
+// Struct fields converts same as in StructParams.
+// StructListValue helps to make rows for BulkUpsert from plain Go slices
+func StructListValue(v interface{}) (types.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%T: %w: must be a slice of structs",
+			v, params.ErrUnsupportedType,
+		))
+	}
+	list, err := params.ReflectValue(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	return list, nil
+}
+
 type Options struct {
-	Idempotent      bool
-	TxSettings      *TransactionSettings
-	TxCommitOptions []options.CommitTransactionOption
-	FastBackoff     backoff.Backoff
-	SlowBackoff     backoff.Backoff
-	Trace           *trace.Table
+	Idempotent        bool
+	HedgingPercentile float64
+	TxSettings        *TransactionSettings
+	TxCommitOptions   []options.CommitTransactionOption
+	FastBackoff       backoff.Backoff
+	SlowBackoff       backoff.Backoff
+	Trace             *trace.Table
 }
 
 type Option func(o *Options)
@@ -569,6 +689,24 @@ func WithIdempotent() Option {
 	}
 }
 
+// WithHedging enables hedged requests for idempotent operations of table.DoWithResult.
+//
+// If attempt of operation is not done during percentile (from 0 to 100) of latencies of previous
+// successful calls - operation calls again concurrently on session of other node and result of
+// first successful call returns. Other call cancels.
+// Hedging is useful for latency-sensitive read-only operations, such as queries with
+// OnlineReadOnlyTxControl or StaleReadOnly transaction control or ReadRows.
+//
+// Hedging has no effect without WithIdempotent option, for table.Client.Do and table.Client.DoTx:
+// results of their operations are saved by op itself, so concurrent attempts cannot be made safe.
+//
+// Warning: op calls concurrently. Op must be safe for concurrent calls
+func WithHedging(percentile float64) Option {
+	return func(o *Options) {
+		o.HedgingPercentile = percentile
+	}
+}
+
 func WithTxSettings(tx *TransactionSettings) Option {
 	return func(o *Options) {
 		o.TxSettings = tx
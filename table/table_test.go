@@ -62,3 +62,74 @@ func TestQueryParameters_String(t *testing.T) {
 		})
 	}
 }
+
+func TestStructParams(t *testing.T) {
+	type base struct {
+		ID uint64 `ydb:"id"`
+	}
+	type series struct {
+		base
+		Title    string  `sql:"title"`
+		Comment  *string `ydb:"comment"`
+		Skipped  int     `ydb:"-"`
+		internal int
+	}
+	type batch struct {
+		Limit  int32
+		Series []series `ydb:"series"`
+	}
+
+	params, err := table.StructParams(&batch{
+		Limit: 10,
+		Series: []series{
+			{base: base{ID: 1}, Title: "IT Crowd"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, table.NewQueryParameters(
+		table.ValueParam("$Limit", types.Int32Value(10)),
+		table.ValueParam("$series", types.ListValue(
+			types.StructValue(
+				types.StructFieldValue("id", types.Uint64Value(1)),
+				types.StructFieldValue("title", types.TextValue("IT Crowd")),
+				types.StructFieldValue("comment", types.NullValue(types.TypeText)),
+			),
+		)),
+	), params)
+
+	_, err = table.StructParams(42)
+	require.Error(t, err)
+
+	_, err = table.StructParams(struct{ C chan int }{})
+	require.Error(t, err)
+}
+
+func TestStructListValue(t *testing.T) {
+	type row struct {
+		ID    uint64 `ydb:"id"`
+		Title string `ydb:"title"`
+	}
+
+	rows, err := table.StructListValue([]row{{ID: 1, Title: "a"}, {ID: 2, Title: "b"}})
+	require.NoError(t, err)
+	require.Equal(t, types.ListValue(
+		types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(1)),
+			types.StructFieldValue("title", types.TextValue("a")),
+		),
+		types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(2)),
+			types.StructFieldValue("title", types.TextValue("b")),
+		),
+	), rows)
+
+	empty, err := table.StructListValue([]row{})
+	require.NoError(t, err)
+	require.Equal(t, types.List(types.Struct(
+		types.StructField("id", types.TypeUint64),
+		types.StructField("title", types.TypeText),
+	)).Yql(), empty.Type().Yql())
+
+	_, err = table.StructListValue(row{})
+	require.Error(t, err)
+}
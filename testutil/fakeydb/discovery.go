@@ -0,0 +1,51 @@
+package fakeydb
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Discovery_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Discovery"
+)
+
+type discoveryService struct {
+	Ydb_Discovery_V1.UnimplementedDiscoveryServiceServer
+
+	s *Server
+}
+
+func (d *discoveryService) ListEndpoints(
+	ctx context.Context, request *Ydb_Discovery.ListEndpointsRequest,
+) (*Ydb_Discovery.ListEndpointsResponse, error) {
+	if err := d.s.checkDatabase(request.GetDatabase()); err != nil {
+		return &Ydb_Discovery.ListEndpointsResponse{Operation: operation(nil, err)}, nil
+	}
+	return &Ydb_Discovery.ListEndpointsResponse{
+		Operation: operation(&Ydb_Discovery.ListEndpointsResult{
+			Endpoints: []*Ydb_Discovery.EndpointInfo{{
+				Address:  host,
+				Port:     port,
+				Location: "fake",
+				NodeId:   1,
+			}},
+			SelfLocation: "fake",
+		}, nil),
+	}, nil
+}
+
+func (d *discoveryService) WhoAmI(
+	ctx context.Context, request *Ydb_Discovery.WhoAmIRequest,
+) (*Ydb_Discovery.WhoAmIResponse, error) {
+	return &Ydb_Discovery.WhoAmIResponse{
+		Operation: operation(&Ydb_Discovery.WhoAmIResult{
+			User: "root",
+		}, nil),
+	}, nil
+}
+
+func (s *Server) checkDatabase(database string) error {
+	if database != "" && cleanPath(database) != s.database {
+		return errorf(Ydb.StatusIds_NOT_FOUND, "database %q not found", database)
+	}
+	return nil
+}
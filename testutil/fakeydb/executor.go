@@ -0,0 +1,511 @@
+package fakeydb
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+)
+
+// transaction keeps writes of rows by tables until commit. Nil row means deleted row
+type transaction struct {
+	id     string
+	writes map[string]map[string][]*Ydb.Value
+}
+
+func newTransaction(id string) *transaction {
+	return &transaction{
+		id:     id,
+		writes: make(map[string]map[string][]*Ydb.Value),
+	}
+}
+
+func (tx *transaction) put(path, key string, row []*Ydb.Value) {
+	writes, has := tx.writes[path]
+	if !has {
+		writes = make(map[string][]*Ydb.Value)
+		tx.writes[path] = writes
+	}
+	writes[key] = row
+}
+
+func (tx *transaction) lookup(path string, t *table, key string) []*Ydb.Value {
+	if row, has := tx.writes[path][key]; has {
+		return row
+	}
+	return t.rows[key]
+}
+
+// rows returns rows of table with writes of transaction ordered by primary key
+func (tx *transaction) rows(path string, t *table) [][]*Ydb.Value {
+	writes := tx.writes[path]
+	if len(writes) == 0 {
+		return t.sortedRows()
+	}
+	view := &table{columns: t.columns, primaryKey: t.primaryKey, rows: make(map[string][]*Ydb.Value, len(t.rows))}
+	for k, row := range t.rows {
+		view.rows[k] = row
+	}
+	for k, row := range writes {
+		if row == nil {
+			delete(view.rows, k)
+		} else {
+			view.rows[k] = row
+		}
+	}
+	return view.sortedRows()
+}
+
+// commitNeedLock applies writes of transaction to tables
+func (s *Server) commitNeedLock(tx *transaction) error {
+	for path := range tx.writes {
+		if _, err := s.entryNeedLock(path, entryTable); err != nil {
+			return errorf(Ydb.StatusIds_ABORTED, "transaction locks invalidated: %v", err)
+		}
+	}
+	for path, writes := range tx.writes {
+		t := s.entries[path].table
+		for k, row := range writes {
+			if row == nil {
+				delete(t.rows, k)
+			} else {
+				t.rows[k] = row
+			}
+		}
+	}
+	tx.writes = make(map[string]map[string][]*Ydb.Value)
+	return nil
+}
+
+// executor executes statements of query within transaction. Server lock must be held
+type executor struct {
+	s      *Server
+	prefix string
+	params map[string]*Ydb.TypedValue
+	tx     *transaction
+}
+
+func (e *executor) execute(statements []statement, scheme bool) (resultSets []*Ydb.ResultSet, err error) {
+	for _, stmt := range statements {
+		var rs *Ydb.ResultSet
+		switch stmt := stmt.(type) {
+		case *pragmaStatement:
+			if stmt.name == "TablePathPrefix" {
+				e.prefix = e.s.absPath("", stmt.value)
+			}
+		case *declareStatement:
+			if _, has := e.params[stmt.name]; !has {
+				return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "missing value for parameter %s", stmt.name)
+			}
+		case *createTableStatement:
+			if !scheme {
+				return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "scheme operations are not allowed in data query")
+			}
+			err = e.s.createTableNeedLock(e.s.absPath(e.prefix, stmt.table), stmt.columns, stmt.primaryKey)
+		case *dropTableStatement:
+			if !scheme {
+				return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "scheme operations are not allowed in data query")
+			}
+			err = e.s.dropEntryNeedLock(e.s.absPath(e.prefix, stmt.table), entryTable)
+			if stmt.ifExists && err != nil {
+				if _, has := e.s.entries[e.s.absPath(e.prefix, stmt.table)]; !has {
+					err = nil
+				}
+			}
+		default:
+			if scheme {
+				return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "data operations are not allowed in scheme query")
+			}
+			rs, err = e.executeData(stmt)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rs != nil {
+			resultSets = append(resultSets, rs)
+		}
+	}
+	return resultSets, nil
+}
+
+func (e *executor) executeData(stmt statement) (*Ydb.ResultSet, error) {
+	switch stmt := stmt.(type) {
+	case *writeStatement:
+		return nil, e.write(stmt)
+	case *selectStatement:
+		return e.selectRows(stmt)
+	case *updateStatement:
+		return nil, e.update(stmt)
+	case *deleteStatement:
+		return nil, e.delete(stmt)
+	default:
+		return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "fakeydb: unsupported statement %T", stmt)
+	}
+}
+
+func (e *executor) table(name string) (string, *table, error) {
+	path := e.s.absPath(e.prefix, name)
+	entry, err := e.s.entryNeedLock(path, entryTable)
+	if err != nil {
+		return "", nil, err
+	}
+	return path, entry.table, nil
+}
+
+func (e *executor) param(name string) (typedValue, error) {
+	p, has := e.params[name]
+	if !has {
+		return typedValue{}, errorf(Ydb.StatusIds_BAD_REQUEST, "missing value for parameter %s", name)
+	}
+	return typedValue{t: p.GetType(), v: p.GetValue()}, nil
+}
+
+func (e *executor) write(stmt *writeStatement) error {
+	path, t, err := e.table(stmt.table)
+	if err != nil {
+		return err
+	}
+	var (
+		columns [][]string
+		values  [][]typedValue
+	)
+	if stmt.source != "" {
+		source, err := e.param(stmt.source)
+		if err != nil {
+			return err
+		}
+		columns, values, err = structRows(source)
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, row := range stmt.rows {
+			rowValues := make([]typedValue, len(row))
+			for i, x := range row {
+				if rowValues[i], err = e.eval(x, nil, nil); err != nil {
+					return err
+				}
+			}
+			columns = append(columns, stmt.columns)
+			values = append(values, rowValues)
+		}
+	}
+	for i := range values {
+		if err = e.writeRow(stmt.mode, path, t, columns[i], values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *executor) writeRow(mode, path string, t *table, columns []string, values []typedValue) error {
+	row, err := t.partialRow(columns, values)
+	if err != nil {
+		return err
+	}
+	key := t.key(row)
+	existing := e.tx.lookup(path, t, key)
+	switch mode {
+	case "insert":
+		if existing != nil {
+			return errorf(Ydb.StatusIds_PRECONDITION_FAILED, "conflict with existing key in table %q", path)
+		}
+		existing = nil
+	case "replace":
+		existing = nil
+	}
+	if row, err = t.completeRow(row, existing); err != nil {
+		return err
+	}
+	e.tx.put(path, key, row)
+	return nil
+}
+
+// structRows returns column names and values of rows from list of structs
+func structRows(source typedValue) (columns [][]string, values [][]typedValue, _ error) {
+	members := source.t.GetListType().GetItem().GetStructType().GetMembers()
+	if members == nil {
+		return nil, nil, errorf(Ydb.StatusIds_BAD_REQUEST,
+			"AS_TABLE requires List<Struct<...>>, got %s", typeString(source.t),
+		)
+	}
+	names := make([]string, len(members))
+	for i, m := range members {
+		names[i] = m.GetName()
+	}
+	for _, item := range source.v.GetItems() {
+		rowValues := make([]typedValue, len(members))
+		for i, m := range members {
+			rowValues[i] = typedValue{t: m.GetType(), v: item.GetItems()[i]}
+		}
+		columns = append(columns, names)
+		values = append(values, rowValues)
+	}
+	return columns, values, nil
+}
+
+func (e *executor) update(stmt *updateStatement) error {
+	path, t, err := e.table(stmt.table)
+	if err != nil {
+		return err
+	}
+	for _, a := range stmt.set {
+		i := t.columnIndex(a.column)
+		if i < 0 {
+			return errorf(Ydb.StatusIds_GENERIC_ERROR, "unknown column %q", a.column)
+		}
+		if t.isKeyColumn(i) {
+			return errorf(Ydb.StatusIds_GENERIC_ERROR, "cannot update key column %q", a.column)
+		}
+	}
+	rows, err := e.filter(path, t, stmt.where)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		updated := append([]*Ydb.Value(nil), row...)
+		for _, a := range stmt.set {
+			i := t.columnIndex(a.column)
+			v, err := e.eval(a.e, t, row)
+			if err != nil {
+				return err
+			}
+			if updated[i], err = castValue(v.v, v.t, t.columns[i].GetType()); err != nil {
+				return err
+			}
+		}
+		e.tx.put(path, t.key(updated), updated)
+	}
+	return nil
+}
+
+func (e *executor) delete(stmt *deleteStatement) error {
+	path, t, err := e.table(stmt.table)
+	if err != nil {
+		return err
+	}
+	rows, err := e.filter(path, t, stmt.where)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		e.tx.put(path, t.key(row), nil)
+	}
+	return nil
+}
+
+// filter returns rows of table which match conditions
+func (e *executor) filter(path string, t *table, where []condition) (rows [][]*Ydb.Value, _ error) {
+	for _, row := range e.tx.rows(path, t) {
+		ok, err := e.match(where, t, row)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (e *executor) match(where []condition, t *table, row []*Ydb.Value) (bool, error) {
+	for _, c := range where {
+		l, err := e.eval(c.left, t, row)
+		if err != nil {
+			return false, err
+		}
+		r, err := e.eval(c.right, t, row)
+		if err != nil {
+			return false, err
+		}
+		if isNullType(l.t) || isNullType(r.t) || isNull(l.v) || isNull(r.v) {
+			return false, nil
+		}
+		// values are compared in type of column
+		if c.left.column == "" && c.right.column != "" {
+			l.v, err = castValue(l.v, l.t, r.t)
+		} else {
+			r.v, err = castValue(r.v, r.t, l.t)
+		}
+		if err != nil {
+			return false, err
+		}
+		cmp := compareValues(l.v, r.v)
+		var ok bool
+		switch c.op {
+		case "=", "==":
+			ok = cmp == 0
+		case "!=", "<>":
+			ok = cmp != 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (e *executor) eval(x expr, t *table, row []*Ydb.Value) (typedValue, error) {
+	switch {
+	case x.literal != nil:
+		return *x.literal, nil
+	case x.param != "":
+		return e.param(x.param)
+	case x.column != "":
+		if t == nil {
+			return typedValue{}, errorf(Ydb.StatusIds_GENERIC_ERROR, "unknown column %q", x.column)
+		}
+		i := t.columnIndex(x.column)
+		if i < 0 {
+			return typedValue{}, errorf(Ydb.StatusIds_GENERIC_ERROR, "unknown column %q", x.column)
+		}
+		return typedValue{t: t.columns[i].GetType(), v: row[i]}, nil
+	case x.cast != nil:
+		inner, err := e.eval(*x.inner, t, row)
+		if err != nil {
+			return typedValue{}, err
+		}
+		v, err := castValue(inner.v, inner.t, x.cast)
+		if err != nil {
+			return typedValue{}, err
+		}
+		return typedValue{t: x.cast, v: v}, nil
+	default:
+		return typedValue{}, errorf(Ydb.StatusIds_GENERIC_ERROR, "fakeydb: unsupported expression")
+	}
+}
+
+// exprType returns type of expression without evaluation
+func (e *executor) exprType(x expr, t *table) (*Ydb.Type, error) {
+	switch {
+	case x.column != "":
+		if t == nil || t.columnIndex(x.column) < 0 {
+			return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "unknown column %q", x.column)
+		}
+		return t.columns[t.columnIndex(x.column)].GetType(), nil
+	case x.count:
+		return primitiveType(Ydb.Type_UINT64), nil
+	case x.cast != nil:
+		return x.cast, nil
+	default:
+		v, err := e.eval(x, nil, nil)
+		return v.t, err
+	}
+}
+
+func (e *executor) selectRows(stmt *selectStatement) (*Ydb.ResultSet, error) {
+	var (
+		path string
+		t    *table
+		rows = [][]*Ydb.Value{nil}
+		err  error
+	)
+	if stmt.table != "" {
+		if path, t, err = e.table(stmt.table); err != nil {
+			return nil, err
+		}
+		if rows, err = e.filter(path, t, stmt.where); err != nil {
+			return nil, err
+		}
+	}
+
+	rs := &Ydb.ResultSet{}
+	var exprs []expr
+	counts := 0
+	for i, item := range stmt.items {
+		if item.star {
+			if t == nil {
+				return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "SELECT * requires FROM")
+			}
+			for _, c := range t.columns {
+				rs.Columns = append(rs.Columns, &Ydb.Column{Name: c.GetName(), Type: c.GetType()})
+				exprs = append(exprs, expr{column: c.GetName()})
+			}
+			continue
+		}
+		typ, err := e.exprType(item.e, t)
+		if err != nil {
+			return nil, err
+		}
+		name := item.name
+		switch {
+		case name != "":
+		case item.e.column != "":
+			name = item.e.column
+		default:
+			name = "column" + strconv.Itoa(i)
+		}
+		if item.e.count {
+			counts++
+		}
+		rs.Columns = append(rs.Columns, &Ydb.Column{Name: name, Type: typ})
+		exprs = append(exprs, item.e)
+	}
+
+	if counts > 0 {
+		if counts != len(exprs) {
+			return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "fakeydb: aggregates with columns are not supported")
+		}
+		row := &Ydb.Value{}
+		for range exprs {
+			row.Items = append(row.Items, &Ydb.Value{Value: &Ydb.Value_Uint64Value{Uint64Value: uint64(len(rows))}})
+		}
+		rs.Rows = append(rs.Rows, row)
+		return rs, nil
+	}
+
+	if len(stmt.orderBy) > 0 {
+		if t == nil {
+			return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "ORDER BY requires FROM")
+		}
+		indexes := make([]int, len(stmt.orderBy))
+		for i, o := range stmt.orderBy {
+			if indexes[i] = t.columnIndex(o.column); indexes[i] < 0 {
+				return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "unknown column %q", o.column)
+			}
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			for k, o := range stmt.orderBy {
+				if c := compareValues(rows[i][indexes[k]], rows[j][indexes[k]]); c != 0 {
+					return (c < 0) != o.desc
+				}
+			}
+			return false
+		})
+	}
+
+	if stmt.limit != nil {
+		limit, err := e.eval(*stmt.limit, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		n, err := castValue(limit.v, limit.t, primitiveType(Ydb.Type_UINT64))
+		if err != nil {
+			return nil, err
+		}
+		if n.GetUint64Value() < uint64(len(rows)) {
+			rows = rows[:n.GetUint64Value()]
+		}
+	}
+
+	for _, row := range rows {
+		v := &Ydb.Value{Items: make([]*Ydb.Value, len(exprs))}
+		for i, x := range exprs {
+			item, err := e.eval(x, t, row)
+			if err != nil {
+				return nil, err
+			}
+			v.Items[i] = item.v
+		}
+		rs.Rows = append(rs.Rows, v)
+	}
+	return rs, nil
+}
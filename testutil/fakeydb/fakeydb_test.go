@@ -0,0 +1,277 @@
+package fakeydb_test
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil/fakeydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+func TestTable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv := fakeydb.New()
+	defer srv.Close()
+
+	db, err := srv.Open(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, `
+			CREATE TABLE series (
+				id Uint64 NOT NULL,
+				title Text,
+				PRIMARY KEY (id)
+			)
+		`)
+	})
+	require.NoError(t, err)
+
+	err = db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		_, err := tx.Execute(ctx, `
+			DECLARE $id AS Uint64;
+			DECLARE $title AS Text;
+			UPSERT INTO series (id, title) VALUES ($id, $title);
+		`, table.NewQueryParameters(
+			table.ValueParam("$id", types.Uint64Value(1)),
+			table.ValueParam("$title", types.TextValue("IT Crowd")),
+		))
+		return err
+	})
+	require.NoError(t, err)
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.BulkUpsert(ctx, path.Join(db.Name(), "series"), types.ListValue(
+			types.StructValue(
+				types.StructFieldValue("id", types.Uint64Value(2)),
+				types.StructFieldValue("title", types.TextValue("Silicon Valley")),
+			),
+		))
+	})
+	require.NoError(t, err)
+
+	var titles []string
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, table.DefaultTxControl(), `
+			SELECT id, title FROM series WHERE id >= 1 ORDER BY id DESC;
+		`, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		titles = titles[:0]
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var (
+					id    uint64
+					title *string
+				)
+				if err = res.ScanNamed(named.Required("id", &id), named.Optional("title", &title)); err != nil {
+					return err
+				}
+				titles = append(titles, *title)
+			}
+		}
+		return res.Err()
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Silicon Valley", "IT Crowd"}, titles)
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		desc, err := s.DescribeTable(ctx, path.Join(db.Name(), "series"))
+		if err != nil {
+			return err
+		}
+		require.Equal(t, []string{"id"}, desc.PrimaryKey)
+		require.Len(t, desc.Columns, 2)
+
+		res, err := s.StreamReadTable(ctx, path.Join(db.Name(), "series"),
+			options.ReadGreaterOrEqual(types.TupleValue(types.Uint64Value(2))),
+			options.ReadColumn("title"),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		var count int
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				count++
+			}
+		}
+		require.Equal(t, 1, count)
+		return res.Err()
+	})
+	require.NoError(t, err)
+
+	d, err := db.Scheme().ListDirectory(ctx, db.Name())
+	require.NoError(t, err)
+	require.Len(t, d.Children, 1)
+	require.Equal(t, "series", d.Children[0].Name)
+}
+
+func TestTableRollback(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv := fakeydb.New()
+	defer srv.Close()
+
+	db, err := srv.Open(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		if err := s.ExecuteSchemeQuery(ctx, `CREATE TABLE kv (k Text, v Int64, PRIMARY KEY (k))`); err != nil {
+			return err
+		}
+		tx, err := s.BeginTransaction(ctx, table.TxSettings(table.WithSerializableReadWrite()))
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Execute(ctx, `UPSERT INTO kv (k, v) VALUES ("a", 1)`, nil); err != nil {
+			return err
+		}
+		return tx.Rollback(ctx)
+	})
+	require.NoError(t, err)
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, table.DefaultTxControl(), `SELECT COUNT(*) FROM kv`, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		var count uint64
+		require.True(t, res.NextResultSet(ctx))
+		require.True(t, res.NextRow())
+		if err = res.Scan(&count); err != nil {
+			return err
+		}
+		require.Zero(t, count)
+		return res.Err()
+	})
+	require.NoError(t, err)
+}
+
+func TestTopic(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv := fakeydb.New()
+	defer srv.Close()
+
+	db, err := srv.Open(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	topicPath := path.Join(db.Name(), "topic")
+	err = db.Topic().Create(ctx, topicPath,
+		topicoptions.CreateWithConsumer(topictypes.Consumer{Name: "consumer"}),
+	)
+	require.NoError(t, err)
+
+	desc, err := db.Topic().Describe(ctx, topicPath)
+	require.NoError(t, err)
+	require.Len(t, desc.Partitions, 1)
+	require.Len(t, desc.Consumers, 1)
+
+	writer, err := db.Topic().StartWriter(topicPath,
+		topicoptions.WithProducerID("producer"),
+		topicoptions.WithSyncWrite(true),
+	)
+	require.NoError(t, err)
+	err = writer.Write(ctx,
+		topicwriter.Message{Data: strings.NewReader("first")},
+		topicwriter.Message{Data: strings.NewReader("second")},
+	)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close(ctx))
+
+	reader, err := db.Topic().StartReader("consumer", topicoptions.ReadTopic(topicPath))
+	require.NoError(t, err)
+	defer func() {
+		_ = reader.Close(ctx)
+	}()
+
+	var messages []string
+	for len(messages) < 2 {
+		msg, err := reader.ReadMessage(ctx)
+		require.NoError(t, err)
+		content, err := io.ReadAll(msg)
+		require.NoError(t, err)
+		messages = append(messages, string(content))
+		require.NoError(t, reader.Commit(ctx, msg))
+	}
+	require.Equal(t, []string{"first", "second"}, messages)
+}
+
+func TestDatabaseSQL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv := fakeydb.New()
+	defer srv.Close()
+
+	nativeDriver, err := srv.Open(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = nativeDriver.Close(ctx)
+	}()
+
+	connector, err := ydb.Connector(nativeDriver,
+		ydb.WithTablePathPrefix(nativeDriver.Name()),
+		ydb.WithAutoDeclare(),
+	)
+	require.NoError(t, err)
+
+	db := sql.OpenDB(connector)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.ExecContext(ydb.WithQueryMode(ctx, ydb.SchemeQueryMode),
+		`CREATE TABLE users (id Int64 NOT NULL, name Text, PRIMARY KEY (id))`,
+	)
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(ctx, `UPSERT INTO users (id, name) VALUES ($id, $name)`,
+		sql.Named("id", int64(1)),
+		sql.Named("name", "Alice"),
+	)
+	require.NoError(t, err)
+
+	var name string
+	err = db.QueryRowContext(ctx, `SELECT name FROM users WHERE id = $id`, sql.Named("id", int64(1))).Scan(&name)
+	require.NoError(t, err)
+	require.Equal(t, "Alice", name)
+}
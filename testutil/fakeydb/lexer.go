@@ -0,0 +1,138 @@
+package fakeydb
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenQuotedIdent
+	tokenParam
+	tokenNumber
+	tokenString
+	tokenPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// utf8 is true for string literals with u suffix
+	utf8 bool
+}
+
+// is checks that token is the keyword or punctuation (case-insensitive)
+func (t token) is(s string) bool {
+	return (t.kind == tokenIdent || t.kind == tokenPunct) && strings.EqualFold(t.text, s)
+}
+
+func tokenize(query string) ([]token, error) {
+	var (
+		tokens []token
+		rs     = []rune(query)
+	)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(rs) && rs[i+1] == '-':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			for i += 2; i+1 < len(rs) && (rs[i] != '*' || rs[i+1] != '/'); i++ {
+			}
+			if i+1 >= len(rs) {
+				return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "unterminated comment")
+			}
+			i += 2
+		case r == '$' || r == '_' || unicode.IsLetter(r):
+			start := i
+			i++
+			for i < len(rs) && (rs[i] == '_' || unicode.IsLetter(rs[i]) || unicode.IsDigit(rs[i])) {
+				i++
+			}
+			if r == '$' {
+				tokens = append(tokens, token{kind: tokenParam, text: string(rs[start:i])})
+			} else {
+				tokens = append(tokens, token{kind: tokenIdent, text: string(rs[start:i])})
+			}
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(rs) && (unicode.IsDigit(rs[i]) || rs[i] == '.') {
+				i++
+			}
+			// suffixes of numeric literals (u, ul, l, etc.) are ignored
+			for i < len(rs) && unicode.IsLetter(rs[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: strings.TrimRightFunc(string(rs[start:i]), unicode.IsLetter)})
+		case r == '\'' || r == '"' || r == '`':
+			var (
+				sb     strings.Builder
+				closed bool
+			)
+			for i++; i < len(rs); i++ {
+				if rs[i] == '\\' && i+1 < len(rs) {
+					i++
+					sb.WriteRune(unescape(rs[i]))
+					continue
+				}
+				if rs[i] == r {
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(rs[i])
+			}
+			if !closed {
+				return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "unterminated literal")
+			}
+			if r == '`' {
+				tokens = append(tokens, token{kind: tokenQuotedIdent, text: sb.String()})
+				continue
+			}
+			t := token{kind: tokenString, text: sb.String()}
+			if i < len(rs) && (rs[i] == 'u' || rs[i] == 'U') {
+				t.utf8 = true
+				i++
+			} else if i < len(rs) && (rs[i] == 's' || rs[i] == 'S') {
+				i++
+			}
+			tokens = append(tokens, t)
+		default:
+			if i+1 < len(rs) {
+				switch two := string(rs[i : i+2]); two {
+				case "<=", ">=", "!=", "<>", "==":
+					tokens = append(tokens, token{kind: tokenPunct, text: two})
+					i += 2
+					continue
+				}
+			}
+			tokens = append(tokens, token{kind: tokenPunct, text: string(r)})
+			i++
+		}
+	}
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+func unescape(r rune) rune {
+	switch r {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	case '0':
+		return 0
+	default:
+		return r
+	}
+}
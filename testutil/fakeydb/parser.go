@@ -0,0 +1,747 @@
+package fakeydb
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+)
+
+type (
+	statement interface{}
+
+	pragmaStatement struct {
+		name  string
+		value string
+	}
+	declareStatement struct {
+		name string
+		t    *Ydb.Type
+	}
+	createTableStatement struct {
+		table      string
+		columns    []*Ydb_Table.ColumnMeta
+		primaryKey []string
+	}
+	dropTableStatement struct {
+		table    string
+		ifExists bool
+	}
+	writeStatement struct {
+		mode    string // upsert, replace or insert
+		table   string
+		columns []string
+		rows    [][]expr
+		// source is a name of parameter with list of structs for SELECT * FROM AS_TABLE($source)
+		source string
+	}
+	selectStatement struct {
+		items   []selectItem
+		table   string
+		where   []condition
+		orderBy []orderItem
+		limit   *expr
+	}
+	updateStatement struct {
+		table string
+		set   []assignment
+		where []condition
+	}
+	deleteStatement struct {
+		table string
+		where []condition
+	}
+)
+
+type expr struct {
+	column  string
+	param   string
+	literal *typedValue
+	count   bool
+	cast    *Ydb.Type
+	inner   *expr
+}
+
+type selectItem struct {
+	star bool
+	e    expr
+	name string
+}
+
+type condition struct {
+	left  expr
+	op    string
+	right expr
+}
+
+type orderItem struct {
+	column string
+	desc   bool
+}
+
+type assignment struct {
+	column string
+	e      expr
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parse(query string) ([]statement, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	var statements []statement
+	for {
+		for p.accept(";") {
+		}
+		if p.peek().kind == tokenEOF {
+			return statements, nil
+		}
+		stmt, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		if stmt != nil {
+			statements = append(statements, stmt)
+		}
+		if !p.accept(";") && p.peek().kind != tokenEOF {
+			return nil, p.errorf("unexpected %q", p.peek().text)
+		}
+	}
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(s string) bool {
+	if p.peek().is(s) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(s string) error {
+	if !p.accept(s) {
+		return p.errorf("expected %q, got %q", s, p.peek().text)
+	}
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return errorf(Ydb.StatusIds_GENERIC_ERROR, "syntax error: "+format, args...)
+}
+
+// skipStatement skips tokens till the end of statement
+func (p *parser) skipStatement() {
+	for t := p.peek(); t.kind != tokenEOF && !t.is(";"); t = p.peek() {
+		p.next()
+	}
+}
+
+func (p *parser) statement() (statement, error) {
+	switch t := p.next(); {
+	case t.is("PRAGMA"):
+		return p.pragma()
+	case t.is("DECLARE"):
+		return p.declare()
+	case t.is("CREATE"):
+		return p.createTable()
+	case t.is("DROP"):
+		return p.dropTable()
+	case t.is("UPSERT"), t.is("REPLACE"), t.is("INSERT"):
+		return p.write(strings.ToLower(t.text))
+	case t.is("SELECT"):
+		return p.selectStatement()
+	case t.is("UPDATE"):
+		return p.update()
+	case t.is("DELETE"):
+		return p.deleteStatement()
+	default:
+		return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "fakeydb: unsupported statement %q", t.text)
+	}
+}
+
+func (p *parser) pragma() (statement, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &pragmaStatement{name: name}
+	switch {
+	case p.accept("("):
+		if t := p.next(); t.kind == tokenString || t.kind == tokenNumber {
+			stmt.value = t.text
+		} else {
+			return nil, p.errorf("unexpected %q", t.text)
+		}
+		if err = p.expect(")"); err != nil {
+			return nil, err
+		}
+	case p.accept("="):
+		stmt.value = p.next().text
+	}
+	return stmt, nil
+}
+
+func (p *parser) declare() (statement, error) {
+	t := p.next()
+	if t.kind != tokenParam {
+		return nil, p.errorf("expected parameter name, got %q", t.text)
+	}
+	if err := p.expect("AS"); err != nil {
+		return nil, err
+	}
+	typ, err := p.typ()
+	if err != nil {
+		return nil, err
+	}
+	return &declareStatement{name: t.text, t: typ}, nil
+}
+
+func (p *parser) createTable() (statement, error) {
+	if err := p.expect("TABLE"); err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &createTableStatement{table: name}
+	if err = p.expect("("); err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("PRIMARY"):
+			if err = p.expect("KEY"); err != nil {
+				return nil, err
+			}
+			if stmt.primaryKey, err = p.identList(); err != nil {
+				return nil, err
+			}
+		case p.peek().is("INDEX"):
+			return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "fakeydb: secondary indexes are not supported")
+		default:
+			column := &Ydb_Table.ColumnMeta{}
+			if column.Name, err = p.ident(); err != nil {
+				return nil, err
+			}
+			if column.Type, err = p.typ(); err != nil {
+				return nil, err
+			}
+			if p.accept("NOT") {
+				if err = p.expect("NULL"); err != nil {
+					return nil, err
+				}
+			} else {
+				column.Type = optionalType(column.Type)
+			}
+			stmt.columns = append(stmt.columns, column)
+		}
+		if p.accept(")") {
+			break
+		}
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+	// table settings (WITH (...)) are ignored
+	p.skipStatement()
+	return stmt, nil
+}
+
+func (p *parser) dropTable() (statement, error) {
+	if err := p.expect("TABLE"); err != nil {
+		return nil, err
+	}
+	stmt := &dropTableStatement{}
+	if p.accept("IF") {
+		if err := p.expect("EXISTS"); err != nil {
+			return nil, err
+		}
+		stmt.ifExists = true
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	stmt.table = name
+	return stmt, nil
+}
+
+func (p *parser) write(mode string) (statement, error) {
+	if err := p.expect("INTO"); err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &writeStatement{mode: mode, table: name}
+	if p.accept("SELECT") {
+		if err = p.expect("*"); err != nil {
+			return nil, err
+		}
+		if err = p.expect("FROM"); err != nil {
+			return nil, err
+		}
+		if err = p.expect("AS_TABLE"); err != nil {
+			return nil, err
+		}
+		if err = p.expect("("); err != nil {
+			return nil, err
+		}
+		t := p.next()
+		if t.kind != tokenParam {
+			return nil, p.errorf("expected parameter, got %q", t.text)
+		}
+		stmt.source = t.text
+		return stmt, p.expect(")")
+	}
+	if stmt.columns, err = p.identList(); err != nil {
+		return nil, err
+	}
+	if err = p.expect("VALUES"); err != nil {
+		return nil, err
+	}
+	for {
+		if err = p.expect("("); err != nil {
+			return nil, err
+		}
+		var row []expr
+		for {
+			e, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, e)
+			if p.accept(")") {
+				break
+			}
+			if err = p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		if len(row) != len(stmt.columns) {
+			return nil, p.errorf("count of values %d is not equal to count of columns %d", len(row), len(stmt.columns))
+		}
+		stmt.rows = append(stmt.rows, row)
+		if !p.accept(",") {
+			return stmt, nil
+		}
+	}
+}
+
+func (p *parser) selectStatement() (statement, error) {
+	stmt := &selectStatement{}
+	for {
+		if p.accept("*") {
+			stmt.items = append(stmt.items, selectItem{star: true})
+		} else {
+			e, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			item := selectItem{e: e}
+			if p.accept("AS") {
+				if item.name, err = p.ident(); err != nil {
+					return nil, err
+				}
+			}
+			stmt.items = append(stmt.items, item)
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	if !p.accept("FROM") {
+		return stmt, nil
+	}
+	var err error
+	if stmt.table, err = p.ident(); err != nil {
+		return nil, err
+	}
+	if p.accept("AS") {
+		if _, err = p.ident(); err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("WHERE") {
+		if stmt.where, err = p.conditions(); err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("ORDER") {
+		if err = p.expect("BY"); err != nil {
+			return nil, err
+		}
+		for {
+			column, err := p.column()
+			if err != nil {
+				return nil, err
+			}
+			item := orderItem{column: column}
+			if p.accept("DESC") {
+				item.desc = true
+			} else {
+				p.accept("ASC")
+			}
+			stmt.orderBy = append(stmt.orderBy, item)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+	if p.accept("LIMIT") {
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		stmt.limit = &e
+	}
+	return stmt, nil
+}
+
+func (p *parser) update() (statement, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &updateStatement{table: name}
+	if err = p.expect("SET"); err != nil {
+		return nil, err
+	}
+	for {
+		a := assignment{}
+		if a.column, err = p.column(); err != nil {
+			return nil, err
+		}
+		if err = p.expect("="); err != nil {
+			return nil, err
+		}
+		if a.e, err = p.expr(); err != nil {
+			return nil, err
+		}
+		stmt.set = append(stmt.set, a)
+		if !p.accept(",") {
+			break
+		}
+	}
+	if p.accept("WHERE") {
+		if stmt.where, err = p.conditions(); err != nil {
+			return nil, err
+		}
+	}
+	return stmt, nil
+}
+
+func (p *parser) deleteStatement() (statement, error) {
+	if err := p.expect("FROM"); err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	stmt := &deleteStatement{table: name}
+	if p.accept("WHERE") {
+		if stmt.where, err = p.conditions(); err != nil {
+			return nil, err
+		}
+	}
+	return stmt, nil
+}
+
+// conditions parses conjunction of comparisons
+func (p *parser) conditions() (conditions []condition, err error) {
+	for {
+		c := condition{}
+		if c.left, err = p.expr(); err != nil {
+			return nil, err
+		}
+		switch t := p.next(); t.text {
+		case "=", "==", "!=", "<>", "<", "<=", ">", ">=":
+			c.op = t.text
+		default:
+			return nil, p.errorf("unsupported operator %q", t.text)
+		}
+		if c.right, err = p.expr(); err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, c)
+		if !p.accept("AND") {
+			return conditions, nil
+		}
+	}
+}
+
+func (p *parser) expr() (expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenParam:
+		return expr{param: t.text}, nil
+	case tokenNumber:
+		return numberLiteral(t.text, false)
+	case tokenString:
+		if t.utf8 {
+			return literal(primitiveType(Ydb.Type_UTF8), &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: t.text}}), nil
+		}
+		return literal(primitiveType(Ydb.Type_STRING), &Ydb.Value{
+			Value: &Ydb.Value_BytesValue{BytesValue: []byte(t.text)},
+		}), nil
+	case tokenQuotedIdent:
+		return expr{column: t.text}, nil
+	case tokenPunct:
+		if t.text == "-" && p.peek().kind == tokenNumber {
+			return numberLiteral(p.next().text, true)
+		}
+	case tokenIdent:
+		switch {
+		case t.is("TRUE"), t.is("FALSE"):
+			return literal(primitiveType(Ydb.Type_BOOL), &Ydb.Value{Value: &Ydb.Value_BoolValue{BoolValue: t.is("TRUE")}}), nil
+		case t.is("NULL"):
+			return literal(nullType(), nullValue()), nil
+		case t.is("COUNT") && p.peek().is("("):
+			p.next()
+			if err := p.expect("*"); err != nil {
+				return expr{}, err
+			}
+			return expr{count: true}, p.expect(")")
+		case t.is("CAST") && p.peek().is("("):
+			p.next()
+			inner, err := p.expr()
+			if err != nil {
+				return expr{}, err
+			}
+			if err = p.expect("AS"); err != nil {
+				return expr{}, err
+			}
+			typ, err := p.typ()
+			if err != nil {
+				return expr{}, err
+			}
+			return expr{inner: &inner, cast: typ}, p.expect(")")
+		case p.peek().is("("):
+			// typed literals like Uint64("1") or Utf8("text")
+			id, ok := primitiveTypeID(t.text)
+			if !ok {
+				return expr{}, errorf(Ydb.StatusIds_GENERIC_ERROR, "fakeydb: unsupported function %q", t.text)
+			}
+			p.next()
+			inner, err := p.expr()
+			if err != nil {
+				return expr{}, err
+			}
+			return expr{inner: &inner, cast: primitiveType(id)}, p.expect(")")
+		default:
+			p.pos--
+			column, err := p.column()
+			return expr{column: column}, err
+		}
+	}
+	return expr{}, p.errorf("unexpected %q", t.text)
+}
+
+func literal(t *Ydb.Type, v *Ydb.Value) expr {
+	return expr{literal: &typedValue{t: t, v: v}}
+}
+
+func numberLiteral(text string, negative bool) (expr, error) {
+	if strings.Contains(text, ".") {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return expr{}, errorf(Ydb.StatusIds_GENERIC_ERROR, "syntax error: bad number %q", text)
+		}
+		if negative {
+			f = -f
+		}
+		return literal(primitiveType(Ydb.Type_DOUBLE), &Ydb.Value{Value: &Ydb.Value_DoubleValue{DoubleValue: f}}), nil
+	}
+	u, err := strconv.ParseUint(text, 10, 64)
+	if err != nil {
+		return expr{}, errorf(Ydb.StatusIds_GENERIC_ERROR, "syntax error: bad number %q", text)
+	}
+	switch {
+	case !negative && u <= math.MaxInt32:
+		return literal(primitiveType(Ydb.Type_INT32), &Ydb.Value{Value: &Ydb.Value_Int32Value{Int32Value: int32(u)}}), nil
+	case negative && u <= -math.MinInt32:
+		return literal(primitiveType(Ydb.Type_INT32), &Ydb.Value{Value: &Ydb.Value_Int32Value{Int32Value: -int32(u)}}), nil
+	case !negative && u <= math.MaxInt64:
+		return literal(primitiveType(Ydb.Type_INT64), &Ydb.Value{Value: &Ydb.Value_Int64Value{Int64Value: int64(u)}}), nil
+	case negative && u <= math.MaxInt64:
+		return literal(primitiveType(Ydb.Type_INT64), &Ydb.Value{Value: &Ydb.Value_Int64Value{Int64Value: -int64(u)}}), nil
+	case !negative:
+		return literal(primitiveType(Ydb.Type_UINT64), &Ydb.Value{Value: &Ydb.Value_Uint64Value{Uint64Value: u}}), nil
+	default:
+		return expr{}, errorf(Ydb.StatusIds_GENERIC_ERROR, "syntax error: number -%s is out of range", text)
+	}
+}
+
+// ident parses name of table or column
+func (p *parser) ident() (string, error) {
+	t := p.next()
+	if t.kind != tokenIdent && t.kind != tokenQuotedIdent {
+		return "", p.errorf("expected name, got %q", t.text)
+	}
+	return t.text, nil
+}
+
+// column parses column name which may be qualified by table name
+func (p *parser) column() (string, error) {
+	name, err := p.ident()
+	if err != nil {
+		return "", err
+	}
+	for p.accept(".") {
+		if name, err = p.ident(); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+func (p *parser) identList() (names []string, err error) {
+	if err = p.expect("("); err != nil {
+		return nil, err
+	}
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+		if p.accept(")") {
+			return names, nil
+		}
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) typ() (t *Ydb.Type, err error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case strings.EqualFold(name, "Optional"):
+		if t, err = p.typeArgument(); err != nil {
+			return nil, err
+		}
+		t = &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+	case strings.EqualFold(name, "List"):
+		if t, err = p.typeArgument(); err != nil {
+			return nil, err
+		}
+		t = &Ydb.Type{Type: &Ydb.Type_ListType{ListType: &Ydb.ListType{Item: t}}}
+	case strings.EqualFold(name, "Struct"):
+		if t, err = p.structType(); err != nil {
+			return nil, err
+		}
+	case strings.EqualFold(name, "Decimal"):
+		if err = p.expect("("); err != nil {
+			return nil, err
+		}
+		precision, err := strconv.ParseUint(p.next().text, 10, 32)
+		if err != nil {
+			return nil, p.errorf("bad decimal precision")
+		}
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+		scale, err := strconv.ParseUint(p.next().text, 10, 32)
+		if err != nil {
+			return nil, p.errorf("bad decimal scale")
+		}
+		if err = p.expect(")"); err != nil {
+			return nil, err
+		}
+		t = &Ydb.Type{Type: &Ydb.Type_DecimalType{DecimalType: &Ydb.DecimalType{
+			Precision: uint32(precision),
+			Scale:     uint32(scale),
+		}}}
+	default:
+		id, ok := primitiveTypeID(name)
+		if !ok {
+			return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "fakeydb: unsupported type %q", name)
+		}
+		t = primitiveType(id)
+	}
+	for p.accept("?") {
+		t = &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+	}
+	return t, nil
+}
+
+func (p *parser) typeArgument() (*Ydb.Type, error) {
+	if err := p.expect("<"); err != nil {
+		return nil, err
+	}
+	t, err := p.typ()
+	if err != nil {
+		return nil, err
+	}
+	return t, p.expect(">")
+}
+
+func (p *parser) structType() (*Ydb.Type, error) {
+	if err := p.expect("<"); err != nil {
+		return nil, err
+	}
+	s := &Ydb.StructType{}
+	for !p.accept(">") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(":"); err != nil {
+			return nil, err
+		}
+		t, err := p.typ()
+		if err != nil {
+			return nil, err
+		}
+		s.Members = append(s.Members, &Ydb.StructMember{Name: name, Type: t})
+		if !p.accept(",") {
+			if err = p.expect(">"); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return &Ydb.Type{Type: &Ydb.Type_StructType{StructType: s}}, nil
+}
+
+func primitiveTypeID(name string) (Ydb.Type_PrimitiveTypeId, bool) {
+	name = strings.ToUpper(name)
+	switch name {
+	case "TEXT":
+		name = "UTF8"
+	case "BYTES":
+		name = "STRING"
+	case "JSONDOCUMENT":
+		name = "JSON_DOCUMENT"
+	case "TYPE_ID_UNSPECIFIED":
+		return 0, false
+	}
+	id, ok := Ydb.Type_PrimitiveTypeId_value[name]
+	return Ydb.Type_PrimitiveTypeId(id), ok
+}
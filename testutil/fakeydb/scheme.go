@@ -0,0 +1,214 @@
+package fakeydb
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+)
+
+type entryKind int
+
+const (
+	entryDirectory entryKind = iota
+	entryTable
+	entryTopic
+)
+
+func (k entryKind) String() string {
+	switch k {
+	case entryTable:
+		return "table"
+	case entryTopic:
+		return "topic"
+	default:
+		return "directory"
+	}
+}
+
+func (k entryKind) toYDB() Ydb_Scheme.Entry_Type {
+	switch k {
+	case entryTable:
+		return Ydb_Scheme.Entry_TABLE
+	case entryTopic:
+		return Ydb_Scheme.Entry_TOPIC
+	default:
+		return Ydb_Scheme.Entry_DIRECTORY
+	}
+}
+
+type entry struct {
+	kind  entryKind
+	table *table
+	topic *topic
+}
+
+func cleanPath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// absPath makes absolute path from path relative to prefix (database by default)
+func (s *Server) absPath(prefix, p string) string {
+	if strings.HasPrefix(p, "/") {
+		return cleanPath(p)
+	}
+	if prefix == "" {
+		prefix = s.database
+	}
+	return cleanPath(prefix + "/" + p)
+}
+
+// createEntryNeedLock creates entry and all parent directories of entry
+func (s *Server) createEntryNeedLock(p string, e *entry) error {
+	if p == s.database || !strings.HasPrefix(p, s.database+"/") {
+		return errorf(Ydb.StatusIds_SCHEME_ERROR, "path %q is not in database %q", p, s.database)
+	}
+	if existing, has := s.entries[p]; has {
+		if existing.kind == entryDirectory && e.kind == entryDirectory {
+			return nil
+		}
+		return errorf(Ydb.StatusIds_ALREADY_EXISTS, "path %q already exists", p)
+	}
+	for dir := path.Dir(p); dir != s.database; dir = path.Dir(dir) {
+		if parent, has := s.entries[dir]; !has {
+			s.entries[dir] = &entry{kind: entryDirectory}
+		} else if parent.kind != entryDirectory {
+			return errorf(Ydb.StatusIds_SCHEME_ERROR, "path %q is not a directory", dir)
+		}
+	}
+	s.entries[p] = e
+	return nil
+}
+
+func (s *Server) entryNeedLock(p string, kind entryKind) (*entry, error) {
+	e, has := s.entries[p]
+	if !has {
+		return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "path %q not found", p)
+	}
+	if e.kind != kind {
+		return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "path %q is a %s, not a %s", p, e.kind, kind)
+	}
+	return e, nil
+}
+
+func (s *Server) dropEntryNeedLock(p string, kind entryKind) error {
+	if _, err := s.entryNeedLock(p, kind); err != nil {
+		return err
+	}
+	if kind == entryDirectory && len(s.childrenNeedLock(p)) > 0 {
+		return errorf(Ydb.StatusIds_SCHEME_ERROR, "directory %q is not empty", p)
+	}
+	delete(s.entries, p)
+	return nil
+}
+
+func (s *Server) childrenNeedLock(dir string) (children []string) {
+	for p := range s.entries {
+		if path.Dir(p) == dir && p != dir {
+			children = append(children, p)
+		}
+	}
+	sort.Strings(children)
+	return children
+}
+
+func (s *Server) schemeEntryNeedLock(p string) *Ydb_Scheme.Entry {
+	e := &Ydb_Scheme.Entry{
+		Name:  path.Base(p),
+		Owner: "root",
+		Type:  s.entries[p].kind.toYDB(),
+	}
+	if p == s.database {
+		e.Type = Ydb_Scheme.Entry_DATABASE
+	}
+	return e
+}
+
+type schemeService struct {
+	Ydb_Scheme_V1.UnimplementedSchemeServiceServer
+
+	s *Server
+}
+
+func (ss *schemeService) MakeDirectory(
+	ctx context.Context, request *Ydb_Scheme.MakeDirectoryRequest,
+) (*Ydb_Scheme.MakeDirectoryResponse, error) {
+	ss.s.mu.Lock()
+	defer ss.s.mu.Unlock()
+
+	err := ss.s.createEntryNeedLock(ss.s.absPath("", request.GetPath()), &entry{kind: entryDirectory})
+
+	return &Ydb_Scheme.MakeDirectoryResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ss *schemeService) RemoveDirectory(
+	ctx context.Context, request *Ydb_Scheme.RemoveDirectoryRequest,
+) (*Ydb_Scheme.RemoveDirectoryResponse, error) {
+	ss.s.mu.Lock()
+	defer ss.s.mu.Unlock()
+
+	err := ss.s.dropEntryNeedLock(ss.s.absPath("", request.GetPath()), entryDirectory)
+
+	return &Ydb_Scheme.RemoveDirectoryResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ss *schemeService) ListDirectory(
+	ctx context.Context, request *Ydb_Scheme.ListDirectoryRequest,
+) (*Ydb_Scheme.ListDirectoryResponse, error) {
+	ss.s.mu.Lock()
+	defer ss.s.mu.Unlock()
+
+	p := ss.s.absPath("", request.GetPath())
+	if _, err := ss.s.entryNeedLock(p, entryDirectory); err != nil {
+		return &Ydb_Scheme.ListDirectoryResponse{Operation: operation(nil, err)}, nil
+	}
+	result := &Ydb_Scheme.ListDirectoryResult{
+		Self: ss.s.schemeEntryNeedLock(p),
+	}
+	for _, child := range ss.s.childrenNeedLock(p) {
+		result.Children = append(result.Children, ss.s.schemeEntryNeedLock(child))
+	}
+
+	return &Ydb_Scheme.ListDirectoryResponse{Operation: operation(result, nil)}, nil
+}
+
+func (ss *schemeService) DescribePath(
+	ctx context.Context, request *Ydb_Scheme.DescribePathRequest,
+) (*Ydb_Scheme.DescribePathResponse, error) {
+	ss.s.mu.Lock()
+	defer ss.s.mu.Unlock()
+
+	p := ss.s.absPath("", request.GetPath())
+	if _, has := ss.s.entries[p]; !has {
+		return &Ydb_Scheme.DescribePathResponse{
+			Operation: operation(nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "path %q not found", p)),
+		}, nil
+	}
+
+	return &Ydb_Scheme.DescribePathResponse{
+		Operation: operation(&Ydb_Scheme.DescribePathResult{
+			Self: ss.s.schemeEntryNeedLock(p),
+		}, nil),
+	}, nil
+}
+
+func (ss *schemeService) ModifyPermissions(
+	ctx context.Context, request *Ydb_Scheme.ModifyPermissionsRequest,
+) (*Ydb_Scheme.ModifyPermissionsResponse, error) {
+	ss.s.mu.Lock()
+	defer ss.s.mu.Unlock()
+
+	p := ss.s.absPath("", request.GetPath())
+	if _, has := ss.s.entries[p]; !has {
+		return &Ydb_Scheme.ModifyPermissionsResponse{
+			Operation: operation(nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "path %q not found", p)),
+		}, nil
+	}
+
+	// permissions are not checked by fake server
+	return &Ydb_Scheme.ModifyPermissionsResponse{Operation: operation(nil, nil)}, nil
+}
@@ -0,0 +1,124 @@
+// Package fakeydb provides in-process fake of YDB server for unit tests.
+//
+// Server implements Discovery, Scheme, Table and Topic grpc services over in-memory
+// listener (bufconn), so ydb.Open works without network and docker:
+//
+//	srv := fakeydb.New()
+//	defer srv.Close()
+//
+//	db, err := srv.Open(ctx)
+//
+// Table service supports sessions, transactions, bulk upsert, read rows, read table
+// and the small subset of YQL: CREATE TABLE, DROP TABLE, UPSERT/REPLACE/INSERT INTO ... VALUES,
+// UPSERT/REPLACE INTO ... SELECT * FROM AS_TABLE($rows), SELECT with WHERE, ORDER BY and LIMIT,
+// UPDATE and DELETE. Transactions see their own writes and are applied atomically on commit,
+// but conflicts of concurrent transactions are not detected.
+//
+// Topic service supports create, describe, drop topics, writing and reading messages
+// with commits of offsets by consumers.
+package fakeydb
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Discovery_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+)
+
+const (
+	host = "localhost"
+	port = 2135
+
+	bufferSize = 1 << 20
+)
+
+// Server is an in-process fake of YDB server
+type Server struct {
+	database string
+
+	listener   *bufconn.Listener
+	grpcServer *grpc.Server
+
+	mu       sync.Mutex
+	nextID   uint64
+	entries  map[string]*entry
+	sessions map[string]*session
+	topicsCh chan struct{} // closed and replaced on each write into topics
+}
+
+type Option func(s *Server)
+
+// WithDatabase specifies the name of database. Default database is "/local"
+func WithDatabase(database string) Option {
+	return func(s *Server) {
+		s.database = cleanPath(database)
+	}
+}
+
+// New starts fake server
+func New(opts ...Option) *Server {
+	s := &Server{
+		database: "/local",
+		listener: bufconn.Listen(bufferSize),
+		sessions: make(map[string]*session),
+		topicsCh: make(chan struct{}),
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(s)
+		}
+	}
+	s.entries = map[string]*entry{
+		s.database: {kind: entryDirectory},
+	}
+	s.grpcServer = grpc.NewServer()
+	Ydb_Discovery_V1.RegisterDiscoveryServiceServer(s.grpcServer, &discoveryService{s: s})
+	Ydb_Scheme_V1.RegisterSchemeServiceServer(s.grpcServer, &schemeService{s: s})
+	Ydb_Table_V1.RegisterTableServiceServer(s.grpcServer, &tableService{s: s})
+	Ydb_Topic_V1.RegisterTopicServiceServer(s.grpcServer, &topicService{s: s})
+	go func() {
+		_ = s.grpcServer.Serve(s.listener)
+	}()
+	return s
+}
+
+// Database returns the name of database
+func (s *Server) Database() string {
+	return s.database
+}
+
+// ConnectionString returns connection string of server for ydb.Open.
+// Driver must be configured with DialOption for connect to server.
+func (s *Server) ConnectionString() string {
+	return "grpc://" + net.JoinHostPort(host, strconv.Itoa(port)) + s.database
+}
+
+// DialOption returns grpc dial option which connects to server over in-memory listener
+func (s *Server) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return s.listener.DialContext(ctx)
+	})
+}
+
+// Open makes driver connected to server
+func (s *Server) Open(ctx context.Context, opts ...ydb.Option) (*ydb.Driver, error) {
+	return ydb.Open(ctx, s.ConnectionString(), append([]ydb.Option{
+		ydb.With(config.WithGrpcOptions(s.DialOption())),
+	}, opts...)...)
+}
+
+// Close stops server
+func (s *Server) Close() {
+	s.grpcServer.Stop()
+	_ = s.listener.Close()
+}
@@ -0,0 +1,68 @@
+package fakeydb
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// statusError is an error of operation with YDB status code
+type statusError struct {
+	code      Ydb.StatusIds_StatusCode
+	issueCode uint32
+	msg       string
+}
+
+func (e *statusError) Error() string {
+	return e.code.String() + ": " + e.msg
+}
+
+func errorf(code Ydb.StatusIds_StatusCode, format string, args ...interface{}) error {
+	return &statusError{
+		code: code,
+		msg:  fmt.Sprintf(format, args...),
+	}
+}
+
+// issueErrorf returns error with issue code, as server reports errors which clients handle specially
+func issueErrorf(code Ydb.StatusIds_StatusCode, issueCode uint32, format string, args ...interface{}) error {
+	return &statusError{
+		code:      code,
+		issueCode: issueCode,
+		msg:       fmt.Sprintf(format, args...),
+	}
+}
+
+// status returns status code and issues of error
+func status(err error) (Ydb.StatusIds_StatusCode, []*Ydb_Issue.IssueMessage) {
+	if err == nil {
+		return Ydb.StatusIds_SUCCESS, nil
+	}
+	var (
+		code      = Ydb.StatusIds_GENERIC_ERROR
+		issueCode uint32
+	)
+	if e, ok := err.(*statusError); ok { //nolint:errorlint
+		code, issueCode = e.code, e.issueCode
+	}
+	return code, []*Ydb_Issue.IssueMessage{{Message: err.Error(), IssueCode: issueCode}}
+}
+
+// operation makes ready operation with result or error
+func operation(result proto.Message, err error) *Ydb_Operations.Operation {
+	op := &Ydb_Operations.Operation{
+		Ready: true,
+	}
+	op.Status, op.Issues = status(err)
+	if err == nil && result != nil {
+		op.Result, err = anypb.New(result)
+		if err != nil {
+			op.Status, op.Issues = status(errorf(Ydb.StatusIds_INTERNAL_ERROR, "%v", err))
+		}
+	}
+	return op
+}
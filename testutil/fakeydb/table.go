@@ -0,0 +1,192 @@
+package fakeydb
+
+import (
+	"sort"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+)
+
+// table is an in-memory table. Rows are stored by serialized primary key
+type table struct {
+	columns    []*Ydb_Table.ColumnMeta
+	primaryKey []int
+	rows       map[string][]*Ydb.Value
+}
+
+func newTable(columns []*Ydb_Table.ColumnMeta, primaryKey []string) (*table, error) {
+	if len(columns) == 0 {
+		return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "table must have columns")
+	}
+	if len(primaryKey) == 0 {
+		return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "table must have primary key")
+	}
+	t := &table{
+		columns: make([]*Ydb_Table.ColumnMeta, len(columns)),
+		rows:    make(map[string][]*Ydb.Value),
+	}
+	for i, c := range columns {
+		if t.columnIndex(c.GetName()) >= 0 {
+			return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "duplicate column %q", c.GetName())
+		}
+		t.columns[i] = &Ydb_Table.ColumnMeta{Name: c.GetName(), Type: c.GetType(), Family: c.GetFamily()}
+	}
+	for _, name := range primaryKey {
+		i := t.columnIndex(name)
+		if i < 0 {
+			return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "unknown primary key column %q", name)
+		}
+		t.primaryKey = append(t.primaryKey, i)
+	}
+	return t, nil
+}
+
+func (t *table) columnIndex(name string) int {
+	for i, c := range t.columns {
+		if c != nil && c.GetName() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *table) primaryKeyNames() []string {
+	names := make([]string, len(t.primaryKey))
+	for i, idx := range t.primaryKey {
+		names[i] = t.columns[idx].GetName()
+	}
+	return names
+}
+
+func (t *table) isKeyColumn(i int) bool {
+	for _, idx := range t.primaryKey {
+		if idx == i {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *table) key(row []*Ydb.Value) string {
+	key := &Ydb.Value{Items: make([]*Ydb.Value, len(t.primaryKey))}
+	for i, idx := range t.primaryKey {
+		key.Items[i] = row[idx]
+	}
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(key)
+	return string(b)
+}
+
+func (t *table) compareKeys(a, b []*Ydb.Value) int {
+	for _, idx := range t.primaryKey {
+		if c := compareValues(a[idx], b[idx]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func (t *table) clone() *table {
+	c := &table{
+		columns:    append([]*Ydb_Table.ColumnMeta(nil), t.columns...),
+		primaryKey: append([]int(nil), t.primaryKey...),
+		rows:       make(map[string][]*Ydb.Value, len(t.rows)),
+	}
+	for k, row := range t.rows {
+		c.rows[k] = row
+	}
+	return c
+}
+
+func (t *table) addColumns(columns []*Ydb_Table.ColumnMeta) error {
+	for _, c := range columns {
+		if t.columnIndex(c.GetName()) >= 0 {
+			return errorf(Ydb.StatusIds_SCHEME_ERROR, "column %q already exists", c.GetName())
+		}
+		if c.GetType().GetOptionalType() == nil {
+			return errorf(Ydb.StatusIds_SCHEME_ERROR, "added column %q must be optional", c.GetName())
+		}
+	}
+	for _, c := range columns {
+		t.columns = append(t.columns, &Ydb_Table.ColumnMeta{Name: c.GetName(), Type: c.GetType(), Family: c.GetFamily()})
+		for k, row := range t.rows {
+			t.rows[k] = append(row[:len(row):len(row)], nullValue())
+		}
+	}
+	return nil
+}
+
+func (t *table) dropColumns(names []string) error {
+	for _, name := range names {
+		i := t.columnIndex(name)
+		if i < 0 {
+			return errorf(Ydb.StatusIds_SCHEME_ERROR, "unknown column %q", name)
+		}
+		if t.isKeyColumn(i) {
+			return errorf(Ydb.StatusIds_SCHEME_ERROR, "cannot drop key column %q", name)
+		}
+	}
+	for _, name := range names {
+		i := t.columnIndex(name)
+		t.columns = append(t.columns[:i:i], t.columns[i+1:]...)
+		for j, idx := range t.primaryKey {
+			if idx > i {
+				t.primaryKey[j]--
+			}
+		}
+		for k, row := range t.rows {
+			t.rows[k] = append(row[:i:i], row[i+1:]...)
+		}
+	}
+	return nil
+}
+
+// partialRow makes row of table from values of named columns. Cells of other columns are nil
+func (t *table) partialRow(columns []string, values []typedValue) ([]*Ydb.Value, error) {
+	row := make([]*Ydb.Value, len(t.columns))
+	for i, name := range columns {
+		idx := t.columnIndex(name)
+		if idx < 0 {
+			return nil, errorf(Ydb.StatusIds_GENERIC_ERROR, "unknown column %q", name)
+		}
+		v, err := castValue(values[i].v, values[i].t, t.columns[idx].GetType())
+		if err != nil {
+			return nil, err
+		}
+		row[idx] = v
+	}
+	for _, idx := range t.primaryKey {
+		if row[idx] == nil {
+			return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "missing key column %q", t.columns[idx].GetName())
+		}
+	}
+	return row, nil
+}
+
+// completeRow fills cells of partial row from base row. Cells which are not in base row are NULL
+func (t *table) completeRow(row, base []*Ydb.Value) ([]*Ydb.Value, error) {
+	for i, v := range row {
+		switch {
+		case v != nil:
+		case i < len(base) && base[i] != nil:
+			row[i] = base[i]
+		case t.columns[i].GetType().GetOptionalType() == nil:
+			return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "missing not null column %q", t.columns[i].GetName())
+		default:
+			row[i] = nullValue()
+		}
+	}
+	return row, nil
+}
+
+// sortedRows returns rows of table ordered by primary key
+func (t *table) sortedRows() [][]*Ydb.Value {
+	rows := make([][]*Ydb.Value, 0, len(t.rows))
+	for _, row := range t.rows {
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return t.compareKeys(rows[i], rows[j]) < 0
+	})
+	return rows
+}
@@ -0,0 +1,637 @@
+package fakeydb
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+)
+
+// issueCodePreparedQueryNotFound is an issue code of error about query id which is missing in session
+const issueCodePreparedQueryNotFound = 2019
+
+type session struct {
+	id       string
+	prepared map[string]string
+	txs      map[string]*transaction
+}
+
+func (s *Server) newIDNeedLock() string {
+	s.nextID++
+	return strconv.FormatUint(s.nextID, 10)
+}
+
+func (s *Server) sessionNeedLock(id string) (*session, error) {
+	session, has := s.sessions[id]
+	if !has {
+		return nil, errorf(Ydb.StatusIds_BAD_SESSION, "session %q not found", id)
+	}
+	return session, nil
+}
+
+func (s *Server) createTableNeedLock(path string, columns []*Ydb_Table.ColumnMeta, primaryKey []string) error {
+	t, err := newTable(columns, primaryKey)
+	if err != nil {
+		return err
+	}
+	return s.createEntryNeedLock(path, &entry{kind: entryTable, table: t})
+}
+
+type tableService struct {
+	Ydb_Table_V1.UnimplementedTableServiceServer
+
+	s *Server
+}
+
+func (ts *tableService) CreateSession(
+	ctx context.Context, request *Ydb_Table.CreateSessionRequest,
+) (*Ydb_Table.CreateSessionResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	id := "ydb://session/3?node_id=1&id=" + ts.s.newIDNeedLock()
+	ts.s.sessions[id] = &session{
+		id:       id,
+		prepared: make(map[string]string),
+		txs:      make(map[string]*transaction),
+	}
+
+	return &Ydb_Table.CreateSessionResponse{
+		Operation: operation(&Ydb_Table.CreateSessionResult{SessionId: id}, nil),
+	}, nil
+}
+
+func (ts *tableService) DeleteSession(
+	ctx context.Context, request *Ydb_Table.DeleteSessionRequest,
+) (*Ydb_Table.DeleteSessionResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	_, err := ts.s.sessionNeedLock(request.GetSessionId())
+	delete(ts.s.sessions, request.GetSessionId())
+
+	return &Ydb_Table.DeleteSessionResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) KeepAlive(
+	ctx context.Context, request *Ydb_Table.KeepAliveRequest,
+) (*Ydb_Table.KeepAliveResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	if _, err := ts.s.sessionNeedLock(request.GetSessionId()); err != nil {
+		return &Ydb_Table.KeepAliveResponse{Operation: operation(nil, err)}, nil
+	}
+
+	return &Ydb_Table.KeepAliveResponse{
+		Operation: operation(&Ydb_Table.KeepAliveResult{
+			SessionStatus: Ydb_Table.KeepAliveResult_SESSION_STATUS_READY,
+		}, nil),
+	}, nil
+}
+
+func (ts *tableService) CreateTable(
+	ctx context.Context, request *Ydb_Table.CreateTableRequest,
+) (*Ydb_Table.CreateTableResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := ts.s.createTableNeedLock(ts.s.absPath("", request.GetPath()), request.GetColumns(), request.GetPrimaryKey())
+
+	return &Ydb_Table.CreateTableResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) DropTable(
+	ctx context.Context, request *Ydb_Table.DropTableRequest,
+) (*Ydb_Table.DropTableResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := ts.s.dropEntryNeedLock(ts.s.absPath("", request.GetPath()), entryTable)
+
+	return &Ydb_Table.DropTableResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) AlterTable(
+	ctx context.Context, request *Ydb_Table.AlterTableRequest,
+) (*Ydb_Table.AlterTableResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := func() error {
+		e, err := ts.s.entryNeedLock(ts.s.absPath("", request.GetPath()), entryTable)
+		if err != nil {
+			return err
+		}
+		t := e.table.clone()
+		if err = t.addColumns(request.GetAddColumns()); err != nil {
+			return err
+		}
+		if err = t.dropColumns(request.GetDropColumns()); err != nil {
+			return err
+		}
+		e.table = t
+		return nil
+	}()
+
+	return &Ydb_Table.AlterTableResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) CopyTable(
+	ctx context.Context, request *Ydb_Table.CopyTableRequest,
+) (*Ydb_Table.CopyTableResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := ts.s.copyTableNeedLock(request.GetSourcePath(), request.GetDestinationPath())
+
+	return &Ydb_Table.CopyTableResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) CopyTables(
+	ctx context.Context, request *Ydb_Table.CopyTablesRequest,
+) (*Ydb_Table.CopyTablesResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	var err error
+	for _, item := range request.GetTables() {
+		if err = ts.s.copyTableNeedLock(item.GetSourcePath(), item.GetDestinationPath()); err != nil {
+			break
+		}
+	}
+
+	return &Ydb_Table.CopyTablesResponse{Operation: operation(nil, err)}, nil
+}
+
+func (s *Server) copyTableNeedLock(source, destination string) error {
+	e, err := s.entryNeedLock(s.absPath("", source), entryTable)
+	if err != nil {
+		return err
+	}
+	return s.createEntryNeedLock(s.absPath("", destination), &entry{kind: entryTable, table: e.table.clone()})
+}
+
+func (ts *tableService) DescribeTable(
+	ctx context.Context, request *Ydb_Table.DescribeTableRequest,
+) (*Ydb_Table.DescribeTableResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	path := ts.s.absPath("", request.GetPath())
+	e, err := ts.s.entryNeedLock(path, entryTable)
+	if err != nil {
+		return &Ydb_Table.DescribeTableResponse{Operation: operation(nil, err)}, nil
+	}
+
+	return &Ydb_Table.DescribeTableResponse{
+		Operation: operation(&Ydb_Table.DescribeTableResult{
+			Self:       ts.s.schemeEntryNeedLock(path),
+			Columns:    e.table.columns,
+			PrimaryKey: e.table.primaryKeyNames(),
+		}, nil),
+	}, nil
+}
+
+func (ts *tableService) DescribeTableOptions(
+	ctx context.Context, request *Ydb_Table.DescribeTableOptionsRequest,
+) (*Ydb_Table.DescribeTableOptionsResponse, error) {
+	return &Ydb_Table.DescribeTableOptionsResponse{
+		Operation: operation(&Ydb_Table.DescribeTableOptionsResult{}, nil),
+	}, nil
+}
+
+func (ts *tableService) ExplainDataQuery(
+	ctx context.Context, request *Ydb_Table.ExplainDataQueryRequest,
+) (*Ydb_Table.ExplainDataQueryResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := func() error {
+		if _, err := ts.s.sessionNeedLock(request.GetSessionId()); err != nil {
+			return err
+		}
+		_, err := parse(request.GetYqlText())
+		return err
+	}()
+	if err != nil {
+		return &Ydb_Table.ExplainDataQueryResponse{Operation: operation(nil, err)}, nil
+	}
+
+	return &Ydb_Table.ExplainDataQueryResponse{
+		Operation: operation(&Ydb_Table.ExplainQueryResult{
+			QueryAst:  request.GetYqlText(),
+			QueryPlan: "{}",
+		}, nil),
+	}, nil
+}
+
+func (ts *tableService) PrepareDataQuery(
+	ctx context.Context, request *Ydb_Table.PrepareDataQueryRequest,
+) (*Ydb_Table.PrepareDataQueryResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	result, err := func() (*Ydb_Table.PrepareQueryResult, error) {
+		session, err := ts.s.sessionNeedLock(request.GetSessionId())
+		if err != nil {
+			return nil, err
+		}
+		statements, err := parse(request.GetYqlText())
+		if err != nil {
+			return nil, err
+		}
+		result := &Ydb_Table.PrepareQueryResult{
+			QueryId:         ts.s.newIDNeedLock(),
+			ParametersTypes: make(map[string]*Ydb.Type),
+		}
+		for _, stmt := range statements {
+			if declare, ok := stmt.(*declareStatement); ok {
+				result.ParametersTypes[declare.name] = declare.t
+			}
+		}
+		session.prepared[result.QueryId] = request.GetYqlText()
+		return result, nil
+	}()
+
+	return &Ydb_Table.PrepareDataQueryResponse{Operation: operation(result, err)}, nil
+}
+
+func (ts *tableService) ExecuteDataQuery(
+	ctx context.Context, request *Ydb_Table.ExecuteDataQueryRequest,
+) (*Ydb_Table.ExecuteDataQueryResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	result, err := ts.executeDataQuery(request)
+
+	return &Ydb_Table.ExecuteDataQueryResponse{Operation: operation(result, err)}, nil
+}
+
+func (ts *tableService) executeDataQuery(
+	request *Ydb_Table.ExecuteDataQueryRequest,
+) (*Ydb_Table.ExecuteQueryResult, error) {
+	session, err := ts.s.sessionNeedLock(request.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	query := request.GetQuery().GetYqlText()
+	if id := request.GetQuery().GetId(); id != "" {
+		var has bool
+		if query, has = session.prepared[id]; !has {
+			return nil, issueErrorf(Ydb.StatusIds_NOT_FOUND, issueCodePreparedQueryNotFound, "Query not found: %s", id)
+		}
+	}
+	statements, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx *transaction
+	switch selector := request.GetTxControl().GetTxSelector().(type) {
+	case *Ydb_Table.TransactionControl_BeginTx:
+		tx = newTransaction(ts.s.newIDNeedLock())
+	case *Ydb_Table.TransactionControl_TxId:
+		var has bool
+		if tx, has = session.txs[selector.TxId]; !has {
+			return nil, errorf(Ydb.StatusIds_NOT_FOUND, "transaction %q not found", selector.TxId)
+		}
+	default:
+		return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "transaction control is not specified")
+	}
+
+	e := &executor{s: ts.s, params: request.GetParameters(), tx: tx}
+	resultSets, err := e.execute(statements, false)
+	if err != nil {
+		// failed transaction is invalidated as in real YDB
+		delete(session.txs, tx.id)
+		return nil, err
+	}
+	result := &Ydb_Table.ExecuteQueryResult{
+		ResultSets: resultSets,
+	}
+	if request.GetTxControl().GetCommitTx() {
+		delete(session.txs, tx.id)
+		if err = ts.s.commitNeedLock(tx); err != nil {
+			return nil, err
+		}
+	} else {
+		session.txs[tx.id] = tx
+		result.TxMeta = &Ydb_Table.TransactionMeta{Id: tx.id}
+	}
+	return result, nil
+}
+
+func (ts *tableService) ExecuteSchemeQuery(
+	ctx context.Context, request *Ydb_Table.ExecuteSchemeQueryRequest,
+) (*Ydb_Table.ExecuteSchemeQueryResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := func() error {
+		if _, err := ts.s.sessionNeedLock(request.GetSessionId()); err != nil {
+			return err
+		}
+		statements, err := parse(request.GetYqlText())
+		if err != nil {
+			return err
+		}
+		e := &executor{s: ts.s, tx: newTransaction("")}
+		_, err = e.execute(statements, true)
+		return err
+	}()
+
+	return &Ydb_Table.ExecuteSchemeQueryResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) BeginTransaction(
+	ctx context.Context, request *Ydb_Table.BeginTransactionRequest,
+) (*Ydb_Table.BeginTransactionResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	session, err := ts.s.sessionNeedLock(request.GetSessionId())
+	if err != nil {
+		return &Ydb_Table.BeginTransactionResponse{Operation: operation(nil, err)}, nil
+	}
+	tx := newTransaction(ts.s.newIDNeedLock())
+	session.txs[tx.id] = tx
+
+	return &Ydb_Table.BeginTransactionResponse{
+		Operation: operation(&Ydb_Table.BeginTransactionResult{
+			TxMeta: &Ydb_Table.TransactionMeta{Id: tx.id},
+		}, nil),
+	}, nil
+}
+
+func (ts *tableService) CommitTransaction(
+	ctx context.Context, request *Ydb_Table.CommitTransactionRequest,
+) (*Ydb_Table.CommitTransactionResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := func() error {
+		session, err := ts.s.sessionNeedLock(request.GetSessionId())
+		if err != nil {
+			return err
+		}
+		tx, has := session.txs[request.GetTxId()]
+		if !has {
+			return errorf(Ydb.StatusIds_NOT_FOUND, "transaction %q not found", request.GetTxId())
+		}
+		delete(session.txs, tx.id)
+		return ts.s.commitNeedLock(tx)
+	}()
+	if err != nil {
+		return &Ydb_Table.CommitTransactionResponse{Operation: operation(nil, err)}, nil
+	}
+
+	return &Ydb_Table.CommitTransactionResponse{
+		Operation: operation(&Ydb_Table.CommitTransactionResult{}, nil),
+	}, nil
+}
+
+func (ts *tableService) RollbackTransaction(
+	ctx context.Context, request *Ydb_Table.RollbackTransactionRequest,
+) (*Ydb_Table.RollbackTransactionResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := func() error {
+		session, err := ts.s.sessionNeedLock(request.GetSessionId())
+		if err != nil {
+			return err
+		}
+		if _, has := session.txs[request.GetTxId()]; !has {
+			return errorf(Ydb.StatusIds_NOT_FOUND, "transaction %q not found", request.GetTxId())
+		}
+		delete(session.txs, request.GetTxId())
+		return nil
+	}()
+
+	return &Ydb_Table.RollbackTransactionResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) BulkUpsert(
+	ctx context.Context, request *Ydb_Table.BulkUpsertRequest,
+) (*Ydb_Table.BulkUpsertResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := func() error {
+		path := ts.s.absPath("", request.GetTable())
+		e, err := ts.s.entryNeedLock(path, entryTable)
+		if err != nil {
+			return err
+		}
+		columns, values, err := structRows(typedValue{t: request.GetRows().GetType(), v: request.GetRows().GetValue()})
+		if err != nil {
+			return err
+		}
+		tx := newTransaction("")
+		for i := range values {
+			row, err := e.table.partialRow(columns[i], values[i])
+			if err != nil {
+				return err
+			}
+			key := e.table.key(row)
+			if row, err = e.table.completeRow(row, tx.lookup(path, e.table, key)); err != nil {
+				return err
+			}
+			tx.put(path, key, row)
+		}
+		return ts.s.commitNeedLock(tx)
+	}()
+
+	return &Ydb_Table.BulkUpsertResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *tableService) ReadRows(
+	ctx context.Context, request *Ydb_Table.ReadRowsRequest,
+) (*Ydb_Table.ReadRowsResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	rs, err := func() (*Ydb.ResultSet, error) {
+		if _, err := ts.s.sessionNeedLock(request.GetSessionId()); err != nil {
+			return nil, err
+		}
+		path := ts.s.absPath("", request.GetPath())
+		e, err := ts.s.entryNeedLock(path, entryTable)
+		if err != nil {
+			return nil, err
+		}
+		columns, values, err := structRows(typedValue{t: request.GetKeys().GetType(), v: request.GetKeys().GetValue()})
+		if err != nil {
+			return nil, err
+		}
+		var rows [][]*Ydb.Value
+		for i := range values {
+			key, err := e.table.partialRow(columns[i], values[i])
+			if err != nil {
+				return nil, err
+			}
+			if row, has := e.table.rows[e.table.key(key)]; has {
+				rows = append(rows, row)
+			}
+		}
+		return projection(e.table, rows, request.GetColumns())
+	}()
+	status, issues := status(err)
+
+	return &Ydb_Table.ReadRowsResponse{
+		Status:    status,
+		Issues:    issues,
+		ResultSet: rs,
+	}, nil
+}
+
+func (ts *tableService) StreamReadTable(
+	request *Ydb_Table.ReadTableRequest, stream Ydb_Table_V1.TableService_StreamReadTableServer,
+) error {
+	rs, err := func() (*Ydb.ResultSet, error) {
+		ts.s.mu.Lock()
+		defer ts.s.mu.Unlock()
+
+		if _, err := ts.s.sessionNeedLock(request.GetSessionId()); err != nil {
+			return nil, err
+		}
+		e, err := ts.s.entryNeedLock(ts.s.absPath("", request.GetPath()), entryTable)
+		if err != nil {
+			return nil, err
+		}
+		var rows [][]*Ydb.Value
+		for _, row := range e.table.sortedRows() {
+			ok, err := inKeyRange(e.table, row, request.GetKeyRange())
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				rows = append(rows, row)
+			}
+			if request.GetRowLimit() > 0 && uint64(len(rows)) == request.GetRowLimit() {
+				break
+			}
+		}
+		return projection(e.table, rows, request.GetColumns())
+	}()
+	status, issues := status(err)
+
+	return stream.Send(&Ydb_Table.ReadTableResponse{
+		Status: status,
+		Issues: issues,
+		Result: &Ydb_Table.ReadTableResult{ResultSet: rs},
+	})
+}
+
+func (ts *tableService) StreamExecuteScanQuery(
+	request *Ydb_Table.ExecuteScanQueryRequest, stream Ydb_Table_V1.TableService_StreamExecuteScanQueryServer,
+) error {
+	resultSets, err := func() ([]*Ydb.ResultSet, error) {
+		ts.s.mu.Lock()
+		defer ts.s.mu.Unlock()
+
+		statements, err := parse(request.GetQuery().GetYqlText())
+		if err != nil {
+			return nil, err
+		}
+		e := &executor{s: ts.s, params: request.GetParameters(), tx: newTransaction("")}
+		return e.execute(statements, false)
+	}()
+	if err != nil {
+		status, issues := status(err)
+		return stream.Send(&Ydb_Table.ExecuteScanQueryPartialResponse{
+			Status: status,
+			Issues: issues,
+		})
+	}
+	for _, rs := range resultSets {
+		if err = stream.Send(&Ydb_Table.ExecuteScanQueryPartialResponse{
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: &Ydb_Table.ExecuteScanQueryPartialResult{ResultSet: rs},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projection makes result set with selected columns of rows. All columns are selected by default
+func projection(t *table, rows [][]*Ydb.Value, columns []string) (*Ydb.ResultSet, error) {
+	indexes := make([]int, 0, len(t.columns))
+	if len(columns) == 0 {
+		for i := range t.columns {
+			indexes = append(indexes, i)
+		}
+	}
+	for _, name := range columns {
+		i := t.columnIndex(name)
+		if i < 0 {
+			return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "unknown column %q", name)
+		}
+		indexes = append(indexes, i)
+	}
+	rs := &Ydb.ResultSet{}
+	for _, i := range indexes {
+		rs.Columns = append(rs.Columns, &Ydb.Column{Name: t.columns[i].GetName(), Type: t.columns[i].GetType()})
+	}
+	for _, row := range rows {
+		v := &Ydb.Value{Items: make([]*Ydb.Value, len(indexes))}
+		for j, i := range indexes {
+			v.Items[j] = row[i]
+		}
+		rs.Rows = append(rs.Rows, v)
+	}
+	return rs, nil
+}
+
+// inKeyRange checks that primary key of row is in key range
+func inKeyRange(t *table, row []*Ydb.Value, r *Ydb_Table.KeyRange) (bool, error) {
+	switch bound := r.GetFromBound().(type) {
+	case *Ydb_Table.KeyRange_Greater:
+		c, err := compareKeyPrefix(t, row, bound.Greater)
+		if err != nil || c <= 0 {
+			return false, err
+		}
+	case *Ydb_Table.KeyRange_GreaterOrEqual:
+		c, err := compareKeyPrefix(t, row, bound.GreaterOrEqual)
+		if err != nil || c < 0 {
+			return false, err
+		}
+	}
+	switch bound := r.GetToBound().(type) {
+	case *Ydb_Table.KeyRange_Less:
+		c, err := compareKeyPrefix(t, row, bound.Less)
+		if err != nil || c >= 0 {
+			return false, err
+		}
+	case *Ydb_Table.KeyRange_LessOrEqual:
+		c, err := compareKeyPrefix(t, row, bound.LessOrEqual)
+		if err != nil || c > 0 {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// compareKeyPrefix compares primary key of row with key prefix (tuple or single value)
+func compareKeyPrefix(t *table, row []*Ydb.Value, prefix *Ydb.TypedValue) (int, error) {
+	types := []*Ydb.Type{prefix.GetType()}
+	values := []*Ydb.Value{prefix.GetValue()}
+	if tuple := prefix.GetType().GetTupleType(); tuple != nil {
+		types, values = tuple.GetElements(), prefix.GetValue().GetItems()
+	}
+	for i := 0; i < len(types) && i < len(t.primaryKey) && i < len(values); i++ {
+		idx := t.primaryKey[i]
+		v, err := castValue(values[i], types[i], t.columns[idx].GetType())
+		if err != nil {
+			return 0, err
+		}
+		if c := compareValues(row[idx], v); c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,336 @@
+package fakeydb
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type topic struct {
+	consumers []*Ydb_Topic.Consumer
+	codecs    []int32
+
+	partitions []*partition
+}
+
+type partition struct {
+	messages  []*message       // index of message is offset
+	lastSeqNo map[string]int64 // by producer id
+	committed map[string]int64 // by consumer name
+}
+
+type message struct {
+	seqNo            int64
+	createdAt        *timestamppb.Timestamp
+	writtenAt        *timestamppb.Timestamp
+	data             []byte
+	uncompressedSize int64
+	codec            int32
+	producerID       string
+	messageGroupID   string
+}
+
+func newTopic(request *Ydb_Topic.CreateTopicRequest) *topic {
+	count := request.GetPartitioningSettings().GetMinActivePartitions()
+	if count <= 0 {
+		count = 1
+	}
+	t := &topic{
+		consumers:  request.GetConsumers(),
+		codecs:     request.GetSupportedCodecs().GetCodecs(),
+		partitions: make([]*partition, count),
+	}
+	for i := range t.partitions {
+		t.partitions[i] = &partition{
+			lastSeqNo: make(map[string]int64),
+			committed: make(map[string]int64),
+		}
+	}
+	return t
+}
+
+func (t *topic) hasConsumer(name string) bool {
+	for _, c := range t.consumers {
+		if c.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *topic) partition(id int64) (*partition, error) {
+	if id < 0 || id >= int64(len(t.partitions)) {
+		return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "partition %d not found", id)
+	}
+	return t.partitions[id], nil
+}
+
+func (t *topic) supportsCodec(codec int32) bool {
+	if len(t.codecs) == 0 {
+		return true
+	}
+	for _, c := range t.codecs {
+		if c == codec {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyTopicsNeedLock wakes up readers which wait new messages
+func (s *Server) notifyTopicsNeedLock() {
+	close(s.topicsCh)
+	s.topicsCh = make(chan struct{})
+}
+
+type topicService struct {
+	Ydb_Topic_V1.UnimplementedTopicServiceServer
+
+	s *Server
+}
+
+func (ts *topicService) CreateTopic(
+	ctx context.Context, request *Ydb_Topic.CreateTopicRequest,
+) (*Ydb_Topic.CreateTopicResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := ts.s.createEntryNeedLock(ts.s.absPath("", request.GetPath()), &entry{
+		kind:  entryTopic,
+		topic: newTopic(request),
+	})
+
+	return &Ydb_Topic.CreateTopicResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *topicService) DescribeTopic(
+	ctx context.Context, request *Ydb_Topic.DescribeTopicRequest,
+) (*Ydb_Topic.DescribeTopicResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	path := ts.s.absPath("", request.GetPath())
+	e, err := ts.s.entryNeedLock(path, entryTopic)
+	if err != nil {
+		return &Ydb_Topic.DescribeTopicResponse{Operation: operation(nil, err)}, nil
+	}
+	result := &Ydb_Topic.DescribeTopicResult{
+		Self: ts.s.schemeEntryNeedLock(path),
+		PartitioningSettings: &Ydb_Topic.PartitioningSettings{
+			MinActivePartitions: int64(len(e.topic.partitions)),
+		},
+		SupportedCodecs: &Ydb_Topic.SupportedCodecs{Codecs: e.topic.codecs},
+		Consumers:       e.topic.consumers,
+	}
+	for i := range e.topic.partitions {
+		result.Partitions = append(result.Partitions, &Ydb_Topic.DescribeTopicResult_PartitionInfo{
+			PartitionId: int64(i),
+			Active:      true,
+		})
+	}
+
+	return &Ydb_Topic.DescribeTopicResponse{Operation: operation(result, nil)}, nil
+}
+
+func (ts *topicService) DropTopic(
+	ctx context.Context, request *Ydb_Topic.DropTopicRequest,
+) (*Ydb_Topic.DropTopicResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := ts.s.dropEntryNeedLock(ts.s.absPath("", request.GetPath()), entryTopic)
+
+	return &Ydb_Topic.DropTopicResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *topicService) CommitOffset(
+	ctx context.Context, request *Ydb_Topic.CommitOffsetRequest,
+) (*Ydb_Topic.CommitOffsetResponse, error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	err := func() error {
+		e, err := ts.s.entryNeedLock(ts.s.absPath("", request.GetPath()), entryTopic)
+		if err != nil {
+			return err
+		}
+		if !e.topic.hasConsumer(request.GetConsumer()) {
+			return errorf(Ydb.StatusIds_SCHEME_ERROR, "consumer %q not found", request.GetConsumer())
+		}
+		p, err := e.topic.partition(request.GetPartitionId())
+		if err != nil {
+			return err
+		}
+		p.committed[request.GetConsumer()] = request.GetOffset()
+		return nil
+	}()
+
+	return &Ydb_Topic.CommitOffsetResponse{Operation: operation(nil, err)}, nil
+}
+
+func (ts *topicService) StreamWrite(stream Ydb_Topic_V1.TopicService_StreamWriteServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	init := request.GetInitRequest()
+	if init == nil {
+		return stream.Send(&Ydb_Topic.StreamWriteMessage_FromServer{
+			Status: Ydb.StatusIds_BAD_REQUEST,
+		})
+	}
+
+	path := ts.s.absPath("", init.GetPath())
+	partitionID, response, err := ts.initWrite(path, init)
+	if err != nil {
+		status, issues := status(err)
+		return stream.Send(&Ydb_Topic.StreamWriteMessage_FromServer{Status: status, Issues: issues})
+	}
+	if err = stream.Send(&Ydb_Topic.StreamWriteMessage_FromServer{
+		Status:        Ydb.StatusIds_SUCCESS,
+		ServerMessage: &Ydb_Topic.StreamWriteMessage_FromServer_InitResponse{InitResponse: response},
+	}); err != nil {
+		return err
+	}
+
+	for {
+		request, err = stream.Recv()
+		if err != nil {
+			return err
+		}
+		var response *Ydb_Topic.StreamWriteMessage_FromServer
+		switch m := request.GetClientMessage().(type) {
+		case *Ydb_Topic.StreamWriteMessage_FromClient_WriteRequest:
+			acks, err := ts.write(path, partitionID, init.GetProducerId(), m.WriteRequest)
+			if err != nil {
+				status, issues := status(err)
+				return stream.Send(&Ydb_Topic.StreamWriteMessage_FromServer{Status: status, Issues: issues})
+			}
+			response = &Ydb_Topic.StreamWriteMessage_FromServer{
+				Status: Ydb.StatusIds_SUCCESS,
+				ServerMessage: &Ydb_Topic.StreamWriteMessage_FromServer_WriteResponse{
+					WriteResponse: &Ydb_Topic.StreamWriteMessage_WriteResponse{
+						Acks:        acks,
+						PartitionId: partitionID,
+						WriteStatistics: &Ydb_Topic.StreamWriteMessage_WriteResponse_WriteStatistics{
+							PersistingTime:         durationpb.New(0),
+							MinQueueWaitTime:       durationpb.New(0),
+							MaxQueueWaitTime:       durationpb.New(0),
+							PartitionQuotaWaitTime: durationpb.New(0),
+							TopicQuotaWaitTime:     durationpb.New(0),
+						},
+					},
+				},
+			}
+		case *Ydb_Topic.StreamWriteMessage_FromClient_UpdateTokenRequest:
+			response = &Ydb_Topic.StreamWriteMessage_FromServer{
+				Status: Ydb.StatusIds_SUCCESS,
+				ServerMessage: &Ydb_Topic.StreamWriteMessage_FromServer_UpdateTokenResponse{
+					UpdateTokenResponse: &Ydb_Topic.UpdateTokenResponse{},
+				},
+			}
+		default:
+			return stream.Send(&Ydb_Topic.StreamWriteMessage_FromServer{
+				Status: Ydb.StatusIds_BAD_REQUEST,
+			})
+		}
+		if err = stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+func (ts *topicService) initWrite(path string, init *Ydb_Topic.StreamWriteMessage_InitRequest) (
+	partitionID int64,
+	_ *Ydb_Topic.StreamWriteMessage_InitResponse,
+	_ error,
+) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	e, err := ts.s.entryNeedLock(path, entryTopic)
+	if err != nil {
+		return 0, nil, err
+	}
+	switch partitioning := init.GetPartitioning().(type) {
+	case *Ydb_Topic.StreamWriteMessage_InitRequest_PartitionId:
+		partitionID = partitioning.PartitionId
+	case *Ydb_Topic.StreamWriteMessage_InitRequest_MessageGroupId:
+		partitionID = partitionByKey(e.topic, partitioning.MessageGroupId)
+	default:
+		partitionID = partitionByKey(e.topic, init.GetProducerId())
+	}
+	p, err := e.topic.partition(partitionID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return partitionID, &Ydb_Topic.StreamWriteMessage_InitResponse{
+		LastSeqNo:       p.lastSeqNo[init.GetProducerId()],
+		SessionId:       ts.s.newIDNeedLock(),
+		PartitionId:     partitionID,
+		SupportedCodecs: &Ydb_Topic.SupportedCodecs{Codecs: e.topic.codecs},
+	}, nil
+}
+
+func partitionByKey(t *topic, key string) int64 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int64(h.Sum32() % uint32(len(t.partitions)))
+}
+
+func (ts *topicService) write(
+	path string, partitionID int64, producerID string, request *Ydb_Topic.StreamWriteMessage_WriteRequest,
+) (acks []*Ydb_Topic.StreamWriteMessage_WriteResponse_WriteAck, _ error) {
+	ts.s.mu.Lock()
+	defer ts.s.mu.Unlock()
+
+	e, err := ts.s.entryNeedLock(path, entryTopic)
+	if err != nil {
+		return nil, err
+	}
+	if !e.topic.supportsCodec(request.GetCodec()) {
+		return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "codec %d is not supported by topic", request.GetCodec())
+	}
+	p, err := e.topic.partition(partitionID)
+	if err != nil {
+		return nil, err
+	}
+	writtenAt := timestamppb.New(time.Now())
+	for _, m := range request.GetMessages() {
+		ack := &Ydb_Topic.StreamWriteMessage_WriteResponse_WriteAck{SeqNo: m.GetSeqNo()}
+		if m.GetSeqNo() <= p.lastSeqNo[producerID] {
+			ack.MessageWriteStatus = &Ydb_Topic.StreamWriteMessage_WriteResponse_WriteAck_Skipped_{
+				Skipped: &Ydb_Topic.StreamWriteMessage_WriteResponse_WriteAck_Skipped{
+					Reason: Ydb_Topic.StreamWriteMessage_WriteResponse_WriteAck_Skipped_REASON_ALREADY_WRITTEN,
+				},
+			}
+		} else {
+			ack.MessageWriteStatus = &Ydb_Topic.StreamWriteMessage_WriteResponse_WriteAck_Written_{
+				Written: &Ydb_Topic.StreamWriteMessage_WriteResponse_WriteAck_Written{
+					Offset: int64(len(p.messages)),
+				},
+			}
+			p.lastSeqNo[producerID] = m.GetSeqNo()
+			p.messages = append(p.messages, &message{
+				seqNo:            m.GetSeqNo(),
+				createdAt:        m.GetCreatedAt(),
+				writtenAt:        writtenAt,
+				data:             m.GetData(),
+				uncompressedSize: m.GetUncompressedSize(),
+				codec:            request.GetCodec(),
+				producerID:       producerID,
+				messageGroupID:   m.GetMessageGroupId(),
+			})
+		}
+		acks = append(acks, ack)
+	}
+	ts.s.notifyTopicsNeedLock()
+	return acks, nil
+}
@@ -0,0 +1,336 @@
+package fakeydb
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+)
+
+// readSession is a state of one StreamRead call
+type readSession struct {
+	s        *Server
+	stream   Ydb_Topic_V1.TopicService_StreamReadServer
+	consumer string
+
+	bytesSize  int64 // free bytes of client buffer
+	order      []int64
+	partitions map[int64]*readPartition // by partition session id
+}
+
+type readPartition struct {
+	path        string
+	partitionID int64
+	started     bool
+	readOffset  int64
+}
+
+func (ts *topicService) StreamRead(stream Ydb_Topic_V1.TopicService_StreamReadServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	init := request.GetInitRequest()
+	if init == nil {
+		return stream.Send(&Ydb_Topic.StreamReadMessage_FromServer{
+			Status: Ydb.StatusIds_BAD_REQUEST,
+		})
+	}
+
+	rs := &readSession{
+		s:          ts.s,
+		stream:     stream,
+		consumer:   init.GetConsumer(),
+		partitions: make(map[int64]*readPartition),
+	}
+	starts, err := rs.init(init)
+	if err != nil {
+		status, issues := status(err)
+		return stream.Send(&Ydb_Topic.StreamReadMessage_FromServer{Status: status, Issues: issues})
+	}
+	for _, response := range starts {
+		if err = stream.Send(response); err != nil {
+			return err
+		}
+	}
+
+	return rs.serve()
+}
+
+// init registers partition sessions and makes init response with start partition session requests
+func (rs *readSession) init(init *Ydb_Topic.StreamReadMessage_InitRequest) (
+	responses []*Ydb_Topic.StreamReadMessage_FromServer, _ error,
+) {
+	rs.s.mu.Lock()
+	defer rs.s.mu.Unlock()
+
+	responses = append(responses, &Ydb_Topic.StreamReadMessage_FromServer{
+		Status: Ydb.StatusIds_SUCCESS,
+		ServerMessage: &Ydb_Topic.StreamReadMessage_FromServer_InitResponse{
+			InitResponse: &Ydb_Topic.StreamReadMessage_InitResponse{
+				SessionId: rs.s.newIDNeedLock(),
+			},
+		},
+	})
+	for _, settings := range init.GetTopicsReadSettings() {
+		path := rs.s.absPath("", settings.GetPath())
+		e, err := rs.s.entryNeedLock(path, entryTopic)
+		if err != nil {
+			return nil, err
+		}
+		if !e.topic.hasConsumer(rs.consumer) {
+			return nil, errorf(Ydb.StatusIds_SCHEME_ERROR, "consumer %q not found in topic %q", rs.consumer, path)
+		}
+		partitionIDs := settings.GetPartitionIds()
+		if len(partitionIDs) == 0 {
+			for i := range e.topic.partitions {
+				partitionIDs = append(partitionIDs, int64(i))
+			}
+		}
+		for _, partitionID := range partitionIDs {
+			p, err := e.topic.partition(partitionID)
+			if err != nil {
+				return nil, err
+			}
+			id := int64(len(rs.order)) + 1
+			committed := p.committed[rs.consumer]
+			rs.order = append(rs.order, id)
+			rs.partitions[id] = &readPartition{
+				path:        path,
+				partitionID: partitionID,
+				readOffset:  committed,
+			}
+			responses = append(responses, &Ydb_Topic.StreamReadMessage_FromServer{
+				Status: Ydb.StatusIds_SUCCESS,
+				ServerMessage: &Ydb_Topic.StreamReadMessage_FromServer_StartPartitionSessionRequest{
+					StartPartitionSessionRequest: &Ydb_Topic.StreamReadMessage_StartPartitionSessionRequest{
+						PartitionSession: &Ydb_Topic.StreamReadMessage_PartitionSession{
+							PartitionSessionId: id,
+							Path:               path,
+							PartitionId:        partitionID,
+						},
+						CommittedOffset: committed,
+						PartitionOffsets: &Ydb_Topic.OffsetsRange{
+							End: int64(len(p.messages)),
+						},
+					},
+				},
+			})
+		}
+	}
+	return responses, nil
+}
+
+// serve handles client messages and sends new messages of topics while client has free buffer
+func (rs *readSession) serve() error {
+	ctx := rs.stream.Context()
+	requests := make(chan *Ydb_Topic.StreamReadMessage_FromClient)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			request, err := rs.stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case requests <- request:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		rs.s.mu.Lock()
+		response := rs.readNeedLock()
+		newMessages := rs.s.topicsCh
+		rs.s.mu.Unlock()
+
+		if response != nil {
+			if err := rs.stream.Send(response); err != nil {
+				return err
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			return err
+		case <-newMessages:
+		case request := <-requests:
+			response, err := rs.handle(request)
+			if err != nil {
+				status, issues := status(err)
+				return rs.stream.Send(&Ydb_Topic.StreamReadMessage_FromServer{Status: status, Issues: issues})
+			}
+			if response != nil {
+				if err = rs.stream.Send(response); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (rs *readSession) handle(request *Ydb_Topic.StreamReadMessage_FromClient) (
+	*Ydb_Topic.StreamReadMessage_FromServer, error,
+) {
+	rs.s.mu.Lock()
+	defer rs.s.mu.Unlock()
+
+	switch m := request.GetClientMessage().(type) {
+	case *Ydb_Topic.StreamReadMessage_FromClient_ReadRequest:
+		rs.bytesSize += m.ReadRequest.GetBytesSize()
+		return nil, nil
+	case *Ydb_Topic.StreamReadMessage_FromClient_StartPartitionSessionResponse:
+		session, err := rs.partitionSession(m.StartPartitionSessionResponse.GetPartitionSessionId())
+		if err != nil {
+			return nil, err
+		}
+		session.started = true
+		if m.StartPartitionSessionResponse.ReadOffset != nil {
+			session.readOffset = m.StartPartitionSessionResponse.GetReadOffset()
+		}
+		return nil, nil
+	case *Ydb_Topic.StreamReadMessage_FromClient_StopPartitionSessionResponse:
+		delete(rs.partitions, m.StopPartitionSessionResponse.GetPartitionSessionId())
+		return nil, nil
+	case *Ydb_Topic.StreamReadMessage_FromClient_CommitOffsetRequest:
+		return rs.commitNeedLock(m.CommitOffsetRequest)
+	case *Ydb_Topic.StreamReadMessage_FromClient_PartitionSessionStatusRequest:
+		return rs.statusNeedLock(m.PartitionSessionStatusRequest.GetPartitionSessionId())
+	case *Ydb_Topic.StreamReadMessage_FromClient_UpdateTokenRequest:
+		return &Ydb_Topic.StreamReadMessage_FromServer{
+			Status: Ydb.StatusIds_SUCCESS,
+			ServerMessage: &Ydb_Topic.StreamReadMessage_FromServer_UpdateTokenResponse{
+				UpdateTokenResponse: &Ydb_Topic.UpdateTokenResponse{},
+			},
+		}, nil
+	default:
+		return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "unexpected client message %T", m)
+	}
+}
+
+func (rs *readSession) partitionSession(id int64) (*readPartition, error) {
+	session, has := rs.partitions[id]
+	if !has {
+		return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "partition session %d not found", id)
+	}
+	return session, nil
+}
+
+func (rs *readSession) partitionNeedLock(session *readPartition) (*partition, error) {
+	e, err := rs.s.entryNeedLock(session.path, entryTopic)
+	if err != nil {
+		return nil, err
+	}
+	return e.topic.partition(session.partitionID)
+}
+
+func (rs *readSession) commitNeedLock(request *Ydb_Topic.StreamReadMessage_CommitOffsetRequest) (
+	*Ydb_Topic.StreamReadMessage_FromServer, error,
+) {
+	response := &Ydb_Topic.StreamReadMessage_CommitOffsetResponse{}
+	for _, commit := range request.GetCommitOffsets() {
+		session, err := rs.partitionSession(commit.GetPartitionSessionId())
+		if err != nil {
+			return nil, err
+		}
+		p, err := rs.partitionNeedLock(session)
+		if err != nil {
+			return nil, err
+		}
+		for _, offsets := range commit.GetOffsets() {
+			if offsets.GetEnd() > p.committed[rs.consumer] {
+				p.committed[rs.consumer] = offsets.GetEnd()
+			}
+		}
+		response.PartitionsCommittedOffsets = append(response.PartitionsCommittedOffsets,
+			&Ydb_Topic.StreamReadMessage_CommitOffsetResponse_PartitionCommittedOffset{
+				PartitionSessionId: commit.GetPartitionSessionId(),
+				CommittedOffset:    p.committed[rs.consumer],
+			},
+		)
+	}
+	return &Ydb_Topic.StreamReadMessage_FromServer{
+		Status: Ydb.StatusIds_SUCCESS,
+		ServerMessage: &Ydb_Topic.StreamReadMessage_FromServer_CommitOffsetResponse{
+			CommitOffsetResponse: response,
+		},
+	}, nil
+}
+
+func (rs *readSession) statusNeedLock(id int64) (*Ydb_Topic.StreamReadMessage_FromServer, error) {
+	session, err := rs.partitionSession(id)
+	if err != nil {
+		return nil, err
+	}
+	p, err := rs.partitionNeedLock(session)
+	if err != nil {
+		return nil, err
+	}
+	return &Ydb_Topic.StreamReadMessage_FromServer{
+		Status: Ydb.StatusIds_SUCCESS,
+		ServerMessage: &Ydb_Topic.StreamReadMessage_FromServer_PartitionSessionStatusResponse{
+			PartitionSessionStatusResponse: &Ydb_Topic.StreamReadMessage_PartitionSessionStatusResponse{
+				PartitionSessionId: id,
+				PartitionOffsets:   &Ydb_Topic.OffsetsRange{End: int64(len(p.messages))},
+				CommittedOffset:    p.committed[rs.consumer],
+			},
+		},
+	}, nil
+}
+
+// readNeedLock makes read response with unread messages of started partition sessions.
+// Returns nil if client has no free buffer or there are no unread messages.
+func (rs *readSession) readNeedLock() *Ydb_Topic.StreamReadMessage_FromServer {
+	if rs.bytesSize <= 0 {
+		return nil
+	}
+	response := &Ydb_Topic.StreamReadMessage_ReadResponse{}
+	for _, id := range rs.order {
+		session, has := rs.partitions[id]
+		if !has || !session.started || rs.bytesSize <= 0 {
+			continue
+		}
+		p, err := rs.partitionNeedLock(session)
+		if err != nil {
+			continue
+		}
+		data := &Ydb_Topic.StreamReadMessage_ReadResponse_PartitionData{PartitionSessionId: id}
+		var batch *Ydb_Topic.StreamReadMessage_ReadResponse_Batch
+		for ; session.readOffset < int64(len(p.messages)) && rs.bytesSize > 0; session.readOffset++ {
+			m := p.messages[session.readOffset]
+			if batch == nil || batch.GetProducerId() != m.producerID || batch.GetCodec() != m.codec {
+				batch = &Ydb_Topic.StreamReadMessage_ReadResponse_Batch{
+					ProducerId: m.producerID,
+					Codec:      m.codec,
+					WrittenAt:  m.writtenAt,
+				}
+				data.Batches = append(data.Batches, batch)
+			}
+			batch.MessageData = append(batch.MessageData, &Ydb_Topic.StreamReadMessage_ReadResponse_MessageData{
+				Offset:           session.readOffset,
+				SeqNo:            m.seqNo,
+				CreatedAt:        m.createdAt,
+				Data:             m.data,
+				UncompressedSize: m.uncompressedSize,
+				MessageGroupId:   m.messageGroupID,
+			})
+			rs.bytesSize -= int64(len(m.data))
+			response.BytesSize += int64(len(m.data))
+		}
+		if len(data.Batches) > 0 {
+			response.PartitionData = append(response.PartitionData, data)
+		}
+	}
+	if len(response.PartitionData) == 0 {
+		return nil
+	}
+	return &Ydb_Topic.StreamReadMessage_FromServer{
+		Status:        Ydb.StatusIds_SUCCESS,
+		ServerMessage: &Ydb_Topic.StreamReadMessage_FromServer_ReadResponse{ReadResponse: response},
+	}
+}
@@ -0,0 +1,280 @@
+package fakeydb
+
+import (
+	"bytes"
+	"math"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/proto"
+)
+
+type typedValue struct {
+	t *Ydb.Type
+	v *Ydb.Value
+}
+
+func primitiveType(id Ydb.Type_PrimitiveTypeId) *Ydb.Type {
+	return &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: id}}
+}
+
+func optionalType(t *Ydb.Type) *Ydb.Type {
+	if t.GetOptionalType() != nil {
+		return t
+	}
+	return &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+}
+
+func nullType() *Ydb.Type {
+	return &Ydb.Type{Type: &Ydb.Type_NullType{}}
+}
+
+func nullValue() *Ydb.Value {
+	return &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}
+}
+
+func isNull(v *Ydb.Value) bool {
+	_, ok := v.GetValue().(*Ydb.Value_NullFlagValue)
+	return v == nil || ok
+}
+
+func isNullType(t *Ydb.Type) bool {
+	_, ok := t.GetType().(*Ydb.Type_NullType)
+	return ok
+}
+
+// castValue converts value of type from into value of type to.
+// Numeric values are converted between numeric types, String and Utf8 values are converted between each other.
+func castValue(v *Ydb.Value, from, to *Ydb.Type) (*Ydb.Value, error) {
+	if isNullType(from) {
+		if to.GetOptionalType() == nil {
+			return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "cannot cast NULL to %s", typeString(to))
+		}
+		return nullValue(), nil
+	}
+	if from.GetOptionalType() != nil {
+		if isNull(v) {
+			if to.GetOptionalType() == nil {
+				return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "cannot cast NULL to %s", typeString(to))
+			}
+			return nullValue(), nil
+		}
+		inner := from.GetOptionalType().GetItem()
+		if inner.GetOptionalType() != nil {
+			v = v.GetNestedValue()
+		}
+		return castValue(v, inner, to)
+	}
+	if to.GetOptionalType() != nil {
+		to = to.GetOptionalType().GetItem()
+		if to.GetOptionalType() != nil {
+			v, err := castValue(v, from, to)
+			if err != nil {
+				return nil, err
+			}
+			return &Ydb.Value{Value: &Ydb.Value_NestedValue{NestedValue: v}}, nil
+		}
+	}
+	if proto.Equal(from, to) {
+		return v, nil
+	}
+	fromID, fromPrimitive := from.GetType().(*Ydb.Type_TypeId)
+	toID, toPrimitive := to.GetType().(*Ydb.Type_TypeId)
+	if !fromPrimitive || !toPrimitive {
+		return nil, errorf(Ydb.StatusIds_BAD_REQUEST, "cannot cast %s to %s", typeString(from), typeString(to))
+	}
+	res, ok := castPrimitive(v, toID.TypeId)
+	if !ok {
+		return nil, errorf(Ydb.StatusIds_BAD_REQUEST,
+			"cannot cast %s to %s", fromID.TypeId, toID.TypeId,
+		)
+	}
+	return res, nil
+}
+
+func castPrimitive(v *Ydb.Value, to Ydb.Type_PrimitiveTypeId) (*Ydb.Value, bool) {
+	var (
+		i       int64
+		u       uint64
+		f       float64
+		s       []byte
+		numeric = true
+		signed  = true
+	)
+	switch x := v.GetValue().(type) {
+	case *Ydb.Value_Int32Value:
+		i, f = int64(x.Int32Value), float64(x.Int32Value)
+	case *Ydb.Value_Int64Value:
+		i, f = x.Int64Value, float64(x.Int64Value)
+	case *Ydb.Value_Uint32Value:
+		u, f, signed = uint64(x.Uint32Value), float64(x.Uint32Value), false
+	case *Ydb.Value_Uint64Value:
+		u, f, signed = x.Uint64Value, float64(x.Uint64Value), false
+	case *Ydb.Value_FloatValue:
+		f, i = float64(x.FloatValue), int64(x.FloatValue)
+	case *Ydb.Value_DoubleValue:
+		f, i = x.DoubleValue, int64(x.DoubleValue)
+	case *Ydb.Value_BytesValue:
+		s, numeric = x.BytesValue, false
+	case *Ydb.Value_TextValue:
+		s, numeric = []byte(x.TextValue), false
+	default:
+		return nil, false
+	}
+	if signed {
+		u = uint64(i)
+	} else {
+		i = int64(u)
+	}
+	switch to {
+	case Ydb.Type_INT8, Ydb.Type_INT16, Ydb.Type_INT32:
+		if !numeric || (!signed && u > math.MaxInt32) || (signed && (i > math.MaxInt32 || i < math.MinInt32)) {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_Int32Value{Int32Value: int32(i)}}, true
+	case Ydb.Type_INT64, Ydb.Type_INTERVAL:
+		if !numeric || (!signed && u > math.MaxInt64) {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_Int64Value{Int64Value: i}}, true
+	case Ydb.Type_UINT8, Ydb.Type_UINT16, Ydb.Type_UINT32, Ydb.Type_DATE, Ydb.Type_DATETIME:
+		if !numeric || (signed && i < 0) || u > math.MaxUint32 {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_Uint32Value{Uint32Value: uint32(u)}}, true
+	case Ydb.Type_UINT64, Ydb.Type_TIMESTAMP:
+		if !numeric || (signed && i < 0) {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_Uint64Value{Uint64Value: u}}, true
+	case Ydb.Type_FLOAT:
+		if !numeric {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_FloatValue{FloatValue: float32(f)}}, true
+	case Ydb.Type_DOUBLE:
+		if !numeric {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_DoubleValue{DoubleValue: f}}, true
+	case Ydb.Type_STRING, Ydb.Type_YSON:
+		if numeric {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_BytesValue{BytesValue: s}}, true
+	case Ydb.Type_UTF8, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT, Ydb.Type_DYNUMBER:
+		if numeric {
+			return nil, false
+		}
+		return &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: string(s)}}, true
+	default:
+		return nil, false
+	}
+}
+
+// compareValues compares values of the same type. NULL is less than any other value
+func compareValues(a, b *Ydb.Value) int {
+	switch aNull, bNull := isNull(a), isNull(b); {
+	case aNull && bNull:
+		return 0
+	case aNull:
+		return -1
+	case bNull:
+		return 1
+	}
+	switch x := a.GetValue().(type) {
+	case *Ydb.Value_BoolValue:
+		return compareInts(boolToInt(x.BoolValue), boolToInt(b.GetBoolValue()))
+	case *Ydb.Value_Int32Value:
+		return compareInts(int64(x.Int32Value), int64(b.GetInt32Value()))
+	case *Ydb.Value_Int64Value:
+		return compareInts(x.Int64Value, b.GetInt64Value())
+	case *Ydb.Value_Uint32Value:
+		return compareUints(uint64(x.Uint32Value), uint64(b.GetUint32Value()))
+	case *Ydb.Value_Uint64Value:
+		return compareUints(x.Uint64Value, b.GetUint64Value())
+	case *Ydb.Value_FloatValue:
+		return compareFloats(float64(x.FloatValue), float64(b.GetFloatValue()))
+	case *Ydb.Value_DoubleValue:
+		return compareFloats(x.DoubleValue, b.GetDoubleValue())
+	case *Ydb.Value_BytesValue:
+		return bytes.Compare(x.BytesValue, b.GetBytesValue())
+	case *Ydb.Value_TextValue:
+		return strings.Compare(x.TextValue, b.GetTextValue())
+	case *Ydb.Value_NestedValue:
+		return compareValues(x.NestedValue, b.GetNestedValue())
+	}
+	if c := compareUints(a.GetHigh_128(), b.GetHigh_128()); c != 0 {
+		return c
+	}
+	if c := compareUints(a.GetLow_128(), b.GetLow_128()); c != 0 {
+		return c
+	}
+	for i := 0; i < len(a.GetItems()) && i < len(b.GetItems()); i++ {
+		if c := compareValues(a.GetItems()[i], b.GetItems()[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(int64(len(a.GetItems())), int64(len(b.GetItems())))
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareUints(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func typeString(t *Ydb.Type) string {
+	switch x := t.GetType().(type) {
+	case *Ydb.Type_TypeId:
+		return x.TypeId.String()
+	case *Ydb.Type_OptionalType:
+		return "Optional<" + typeString(x.OptionalType.GetItem()) + ">"
+	case *Ydb.Type_ListType:
+		return "List<" + typeString(x.ListType.GetItem()) + ">"
+	case *Ydb.Type_StructType:
+		members := make([]string, 0, len(x.StructType.GetMembers()))
+		for _, m := range x.StructType.GetMembers() {
+			members = append(members, m.GetName()+":"+typeString(m.GetType()))
+		}
+		return "Struct<" + strings.Join(members, ",") + ">"
+	case *Ydb.Type_NullType:
+		return "Null"
+	default:
+		return t.String()
+	}
+}
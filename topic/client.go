@@ -68,4 +68,18 @@ type Client interface {
 	// Notice: This API is EXPERIMENTAL and may be changed or removed in a
 	// later release.
 	StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error)
+
+	// StartMultiWriter start write sessions to all active partitions of topic
+	// and returns writer which routes messages to partitions by keys or explicit partition IDs.
+	// Writer of each partition has own producer and sequence numbers if producer ID is not set with options
+	//
+	// Experimental
+	//
+	// Notice: This API is EXPERIMENTAL and may be changed or removed in a
+	// later release.
+	StartMultiWriter(
+		ctx context.Context,
+		topicPath string,
+		opts ...topicoptions.WriterOption,
+	) (*topicwriter.MultiWriter, error)
 }
@@ -249,6 +249,62 @@ func WithGetPartitionStartOffset(f GetPartitionStartOffsetFunc) ReaderOption {
 	}
 }
 
+type (
+	// OnPartitionStartFunc
+	//
+	// # Experimental
+	//
+	// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+	OnPartitionStartFunc = topicreaderinternal.PublicOnPartitionStartFunc
+
+	// OnPartitionStartRequest
+	//
+	// # Experimental
+	//
+	// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+	OnPartitionStartRequest = topicreaderinternal.PublicOnPartitionStartRequest
+
+	// OnPartitionStopFunc
+	//
+	// # Experimental
+	//
+	// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+	OnPartitionStopFunc = topicreaderinternal.PublicOnPartitionStopFunc
+
+	// OnPartitionStopRequest
+	//
+	// # Experimental
+	//
+	// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+	OnPartitionStopRequest = topicreaderinternal.PublicOnPartitionStopRequest
+)
+
+// WithOnPartitionStart set callback which called when server starts read of partition.
+// Callback receives context of partition session, which cancels when partition session stops.
+// Use it for load per-partition state.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithOnPartitionStart(f OnPartitionStartFunc) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.OnPartitionStart = f
+	}
+}
+
+// WithOnPartitionStop set callback which called when server stops read of partition.
+// On graceful stop callback called before stop confirmation, messages of partition may be committed
+// within callback. Use it for flush and commit per-partition state.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func WithOnPartitionStop(f OnPartitionStopFunc) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.OnPartitionStop = f
+	}
+}
+
 // WithReaderTrace
 //
 // # Experimental
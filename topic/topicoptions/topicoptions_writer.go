@@ -147,6 +147,8 @@ func WithCodec(codec topictypes.Codec) WriterOption {
 // enabled by default
 // if option enabled - send a batch of messages for every allowed codec (for prevent delayed bad codec accident)
 // then from time to time measure all codecs and select codec with the smallest result messages size
+// allowed codecs are codecs, supported by topic and writer (raw, gzip, zstd, lz4 and custom encoders).
+// If topic has no supported codecs list - allowed codecs are raw and gzip, zstd must be set with WithCodec
 //
 // # Experimental
 //
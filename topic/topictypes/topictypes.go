@@ -22,9 +22,13 @@ const (
 	// CodecLzop not supported by default, customer need provide own codec library
 	CodecLzop = Codec(rawtopiccommon.CodecLzop)
 
-	// CodecZstd not supported by default, customer need provide own codec library
+	// CodecZstd supported by default with pure go implementation
 	CodecZstd = Codec(rawtopiccommon.CodecZstd)
 
+	// CodecLz4 is custom codec for lz4 frame format, supported by default with pure go implementation.
+	// The codec must be added to supported codecs of topic for use it
+	CodecLz4 = Codec(rawtopiccommon.CodecLz4)
+
 	CodecCustomerFirst = Codec(rawtopiccommon.CodecCustomerFirst)
 	CodecCustomerEnd   = Codec(rawtopiccommon.CodecCustomerEnd) // last allowed custom codec id is CodecCustomerEnd-1
 )
@@ -0,0 +1,161 @@
+package topicwriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errUnknownPartition = xerrors.Wrap(errors.New("ydb: unknown partition of topic"))
+
+// KeyedMessage is a message with key for route to partition of topic
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type KeyedMessage struct {
+	Message
+
+	// Key defines partition of message by hash of key.
+	// Messages with same key are written to same partition in order of write
+	Key string
+}
+
+// MultiWriter writes messages to partitions of topic with separate writer (and producer) per partition.
+// Messages routes to partitions by hash of key or by explicit partition ID.
+//
+// Mapping of keys to partitions depends on count of partitions, it changes if partitions of topic are changed.
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+type MultiWriter struct {
+	partitions []int64 // sorted
+	writers    map[int64]*Writer
+}
+
+// NewMultiWriter creates MultiWriter with writers started by startWriter for each of partitions
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func NewMultiWriter(
+	ctx context.Context,
+	partitions []int64,
+	startWriter func(partitionID int64) (*Writer, error),
+) (_ *MultiWriter, err error) {
+	if len(partitions) == 0 {
+		return nil, xerrors.WithStackTrace(errors.New("ydb: topic has no partitions for write"))
+	}
+
+	w := &MultiWriter{
+		partitions: append([]int64(nil), partitions...),
+		writers:    make(map[int64]*Writer, len(partitions)),
+	}
+	sort.Slice(w.partitions, func(i, j int) bool {
+		return w.partitions[i] < w.partitions[j]
+	})
+
+	defer func() {
+		if err != nil {
+			_ = w.Close(ctx)
+		}
+	}()
+
+	for _, partitionID := range w.partitions {
+		writer, err := startWriter(partitionID)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		w.writers[partitionID] = writer
+	}
+
+	return w, nil
+}
+
+// Partitions returns IDs of partitions of MultiWriter
+func (w *MultiWriter) Partitions() []int64 {
+	return append([]int64(nil), w.partitions...)
+}
+
+// PartitionForKey returns ID of partition for messages with key
+func (w *MultiWriter) PartitionForKey(key string) int64 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return w.partitions[h.Sum32()%uint32(len(w.partitions))]
+}
+
+// Write routes messages to partitions by keys and writes them with writers of partitions.
+// Messages of different partitions are written concurrently, order of messages with same key is kept.
+//
+// Semantic of write to partition is the same as Writer.Write
+func (w *MultiWriter) Write(ctx context.Context, messages ...KeyedMessage) error {
+	var (
+		order   []int64
+		batches = make(map[int64][]Message)
+	)
+	for i := range messages {
+		partitionID := w.PartitionForKey(messages[i].Key)
+		if _, has := batches[partitionID]; !has {
+			order = append(order, partitionID)
+		}
+		batches[partitionID] = append(batches[partitionID], messages[i].Message)
+	}
+
+	if len(order) == 1 {
+		return w.WriteToPartition(ctx, order[0], batches[order[0]]...)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for _, partitionID := range order {
+		wg.Add(1)
+		go func(partitionID int64) {
+			defer wg.Done()
+
+			if err := w.WriteToPartition(ctx, partitionID, batches[partitionID]...); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+		}(partitionID)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// WriteToPartition writes messages to partition with partitionID
+//
+// Semantic of write is the same as Writer.Write
+func (w *MultiWriter) WriteToPartition(ctx context.Context, partitionID int64, messages ...Message) error {
+	writer, has := w.writers[partitionID]
+	if !has {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %d", errUnknownPartition, partitionID))
+	}
+	return writer.Write(ctx, messages...)
+}
+
+// Close closes writers of all partitions and returns first error
+func (w *MultiWriter) Close(ctx context.Context) error {
+	var firstErr error
+	for _, partitionID := range w.partitions {
+		if writer := w.writers[partitionID]; writer != nil {
+			if err := writer.Close(ctx); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,244 @@
+package topicwriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestMultiWriter(t *testing.T) {
+	ctx := xtest.Context(t)
+
+	t.Run("PartitionForKey", func(t *testing.T) {
+		w, err := NewMultiWriter(ctx, []int64{2, 0, 1}, func(partitionID int64) (*Writer, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int64{0, 1, 2}, w.Partitions())
+
+		used := map[int64]bool{}
+		for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+			partitionID := w.PartitionForKey(key)
+			require.Equal(t, partitionID, w.PartitionForKey(key))
+			require.Contains(t, w.Partitions(), partitionID)
+			used[partitionID] = true
+		}
+		require.Greater(t, len(used), 1)
+	})
+
+	t.Run("UnknownPartition", func(t *testing.T) {
+		w, err := NewMultiWriter(ctx, []int64{0}, func(partitionID int64) (*Writer, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, w.WriteToPartition(context.Background(), 1), errUnknownPartition)
+	})
+
+	t.Run("NoPartitions", func(t *testing.T) {
+		_, err := NewMultiWriter(ctx, nil, func(partitionID int64) (*Writer, error) {
+			return nil, nil
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("StartError", func(t *testing.T) {
+		testErr := errors.New("test")
+		_, err := NewMultiWriter(ctx, []int64{0, 1}, func(partitionID int64) (*Writer, error) {
+			return nil, testErr
+		})
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestMultiWriterWrite(t *testing.T) {
+	ctx := xtest.Context(t)
+
+	t.Run("SameKeyToSamePartitionInOrder", func(t *testing.T) {
+		topic := newTestTopic()
+		w, err := NewMultiWriter(ctx, []int64{0, 1, 2}, topic.startWriter)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, w.Close(ctx))
+		}()
+
+		keys := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+		expected := make(map[int64][]string)
+		for batch := 0; batch < 3; batch++ {
+			messages := make([]KeyedMessage, 0, len(keys))
+			for _, key := range keys {
+				data := fmt.Sprintf("%s-%d", key, batch)
+				messages = append(messages, KeyedMessage{
+					Message: Message{Data: strings.NewReader(data)},
+					Key:     key,
+				})
+				partitionID := w.PartitionForKey(key)
+				expected[partitionID] = append(expected[partitionID], data)
+			}
+			require.NoError(t, w.Write(ctx, messages...))
+		}
+
+		require.Greater(t, len(expected), 1)
+		require.Equal(t, expected, topic.written())
+	})
+
+	t.Run("WriteToPartition", func(t *testing.T) {
+		topic := newTestTopic()
+		w, err := NewMultiWriter(ctx, []int64{0, 1}, topic.startWriter)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, w.Close(ctx))
+		}()
+
+		require.NoError(t, w.WriteToPartition(ctx, 1,
+			Message{Data: strings.NewReader("first")},
+			Message{Data: strings.NewReader("second")},
+		))
+		require.Equal(t, map[int64][]string{1: {"first", "second"}}, topic.written())
+	})
+
+	t.Run("PartitionError", func(t *testing.T) {
+		topic := newTestTopic()
+		w, err := NewMultiWriter(ctx, []int64{0, 1, 2}, topic.startWriter)
+		require.NoError(t, err)
+		defer func() {
+			_ = w.Close(ctx)
+		}()
+
+		keys := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+		failedPartition := w.PartitionForKey(keys[0])
+		require.NoError(t, w.writers[failedPartition].Close(ctx))
+
+		messages := make([]KeyedMessage, 0, len(keys))
+		expected := make(map[int64][]string)
+		for _, key := range keys {
+			messages = append(messages, KeyedMessage{
+				Message: Message{Data: strings.NewReader(key)},
+				Key:     key,
+			})
+			if partitionID := w.PartitionForKey(key); partitionID != failedPartition {
+				expected[partitionID] = append(expected[partitionID], key)
+			}
+		}
+		require.Error(t, w.Write(ctx, messages...))
+		require.NotEmpty(t, expected)
+		require.Equal(t, expected, topic.written(), "messages of other partitions are written")
+	})
+}
+
+// testTopic stores data of messages written to partitions through fake write streams
+type testTopic struct {
+	m        sync.Mutex
+	messages map[int64][]string
+}
+
+func newTestTopic() *testTopic {
+	return &testTopic{
+		messages: make(map[int64][]string),
+	}
+}
+
+func (topic *testTopic) startWriter(partitionID int64) (*Writer, error) {
+	connect := func(ctx context.Context) (topicwriterinternal.RawTopicWriterStream, error) {
+		return &testPartitionStream{
+			topic:       topic,
+			partitionID: partitionID,
+			responses:   make(chan rawtopicwriter.ServerMessage, 16),
+			closed:      make(chan struct{}),
+		}, nil
+	}
+	writer, err := topicwriterinternal.NewWriter(
+		credentials.NewAnonymousCredentials(),
+		[]topicwriterinternal.PublicWriterOption{
+			topicwriterinternal.WithConnectFunc(connect),
+			topicwriterinternal.WithPartitioning(topicwriterinternal.NewPartitioningWithPartitionID(partitionID)),
+			topicwriterinternal.WithCodec(rawtopiccommon.CodecRaw),
+			topicwriterinternal.WithWaitAckOnWrite(true),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriter(writer), nil
+}
+
+func (topic *testTopic) written() map[int64][]string {
+	topic.m.Lock()
+	defer topic.m.Unlock()
+
+	res := make(map[int64][]string, len(topic.messages))
+	for partitionID, messages := range topic.messages {
+		res[partitionID] = append([]string(nil), messages...)
+	}
+	return res
+}
+
+// testPartitionStream is a fake write stream which acks every message written to partition
+type testPartitionStream struct {
+	topic       *testTopic
+	partitionID int64
+	responses   chan rawtopicwriter.ServerMessage
+	closeOnce   sync.Once
+	closed      chan struct{}
+}
+
+func (s *testPartitionStream) Recv() (rawtopicwriter.ServerMessage, error) {
+	select {
+	case mess := <-s.responses:
+		return mess, nil
+	case <-s.closed:
+		return nil, io.EOF
+	}
+}
+
+func (s *testPartitionStream) Send(mess rawtopicwriter.ClientMessage) error {
+	var response rawtopicwriter.ServerMessage
+	switch m := mess.(type) {
+	case *rawtopicwriter.InitRequest:
+		response = &rawtopicwriter.InitResult{
+			PartitionID:     s.partitionID,
+			SupportedCodecs: rawtopiccommon.SupportedCodecs{rawtopiccommon.CodecRaw},
+		}
+	case *rawtopicwriter.WriteRequest:
+		result := &rawtopicwriter.WriteResult{PartitionID: s.partitionID}
+		s.topic.m.Lock()
+		for i := range m.Messages {
+			s.topic.messages[s.partitionID] = append(s.topic.messages[s.partitionID], string(m.Messages[i].Data))
+			result.Acks = append(result.Acks, rawtopicwriter.WriteAck{
+				SeqNo: m.Messages[i].SeqNo,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type:          rawtopicwriter.WriteStatusTypeWritten,
+					WrittenOffset: int64(len(s.topic.messages[s.partitionID]) - 1),
+				},
+			})
+		}
+		s.topic.m.Unlock()
+		response = result
+	default:
+		return nil
+	}
+	select {
+	case s.responses <- response:
+		return nil
+	case <-s.closed:
+		return io.EOF
+	}
+}
+
+func (s *testPartitionStream) CloseSend() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	return nil
+}
@@ -7,7 +7,9 @@ import (
 )
 
 type (
-	Message = topicwriterinternal.Message
+	Message     = topicwriterinternal.Message
+	WriteResult = topicwriterinternal.PublicWriteResult
+	InitInfo    = topicwriterinternal.PublicWithOnWriterConnectedInfo
 )
 
 var ErrQueueLimitExceed = topicwriterinternal.PublicErrQueueIsFull
@@ -41,6 +43,36 @@ func (w *Writer) Write(ctx context.Context, messages ...Message) error {
 	return w.inner.Write(ctx, messages...)
 }
 
+// WriteWithAck send messages to topic and wait acks from server for them, regardless of topicoptions.WithSyncWrite.
+// It returns result of write (partition, offset or skip as duplicate) for every message in order of messages
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func (w *Writer) WriteWithAck(ctx context.Context, messages ...Message) ([]WriteResult, error) {
+	return w.inner.WriteWithAck(ctx, messages...)
+}
+
+// Flush waits acks from server for all messages, which were written before call.
+// It doesn't close the writer and the writer may be used after flush
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func (w *Writer) Flush(ctx context.Context) error {
+	return w.inner.Flush(ctx)
+}
+
+// WaitInit waits first initialization of write session and returns info from server:
+// last seqno of producer, partition and supported codecs
+//
+// # Experimental
+//
+// Notice: This API is EXPERIMENTAL and may be changed or removed in a later release.
+func (w *Writer) WaitInit(ctx context.Context) (InitInfo, error) {
+	return w.inner.WaitInit(ctx)
+}
+
 func (w *Writer) Close(ctx context.Context) error {
 	return w.inner.Close(ctx)
 }
@@ -1,5 +1,7 @@
 package trace
 
+import "context"
+
 // tool gtrace used from ./internal/cmd/gtrace
 
 //go:generate gtrace
@@ -7,5 +9,85 @@ package trace
 type (
 	// Coordination specified trace of coordination client activity.
 	// gtrace:gen
-	Coordination struct{}
+	Coordination struct {
+		OnSessionStart func(CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo)
+		OnSessionStop  func(CoordinationSessionStopStartInfo) func(CoordinationSessionStopDoneInfo)
+
+		OnSessionKeepAliveTimeout func(CoordinationSessionKeepAliveTimeoutInfo)
+		OnSessionLost             func(CoordinationSessionLostInfo)
+
+		OnElectionCampaign func(CoordinationElectionCampaignStartInfo) func(CoordinationElectionCampaignDoneInfo)
+		OnElectionResign   func(CoordinationElectionResignStartInfo) func(CoordinationElectionResignDoneInfo)
+
+		OnElectionLeadershipLost func(CoordinationElectionLeadershipLostInfo)
+		OnElectionLeaderChanged  func(CoordinationElectionLeaderChangedInfo)
+	}
+	CoordinationSessionStartStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context   *context.Context
+		Path      string
+		SessionID uint64 // zero for a new session, non-zero on reconnect
+	}
+	CoordinationSessionStartDoneInfo struct {
+		SessionID uint64
+		Error     error
+	}
+	CoordinationSessionStopStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context   *context.Context
+		SessionID uint64
+	}
+	CoordinationSessionStopDoneInfo struct {
+		Error error
+	}
+	CoordinationSessionKeepAliveTimeoutInfo struct {
+		SessionID uint64
+	}
+	CoordinationSessionLostInfo struct {
+		SessionID uint64
+		Error     error
+	}
+	CoordinationElectionCampaignStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Path    string
+		Name    string
+	}
+	CoordinationElectionCampaignDoneInfo struct {
+		SessionID uint64
+		OrderID   uint64
+		Error     error
+	}
+	CoordinationElectionResignStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Path    string
+		Name    string
+	}
+	CoordinationElectionResignDoneInfo struct {
+		Error error
+	}
+	CoordinationElectionLeadershipLostInfo struct {
+		Path      string
+		Name      string
+		SessionID uint64
+	}
+	CoordinationElectionLeaderChangedInfo struct {
+		Path      string
+		Name      string
+		SessionID uint64
+		OrderID   uint64
+	}
 )
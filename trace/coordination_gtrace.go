@@ -2,6 +2,10 @@
 
 package trace
 
+import (
+	"context"
+)
+
 // coordinationComposeOptions is a holder of options
 type coordinationComposeOptions struct {
 	panicCallback func(e interface{})
@@ -20,5 +24,391 @@ func WithCoordinationPanicCallback(cb func(e interface{})) CoordinationComposeOp
 // Compose returns a new Coordination which has functional fields composed both from t and x.
 func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOption) *Coordination {
 	var ret Coordination
+	options := coordinationComposeOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+	{
+		h1 := t.OnSessionStart
+		h2 := x.OnSessionStart
+		ret.OnSessionStart = func(c CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationSessionStartDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationSessionStartDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionStop
+		h2 := x.OnSessionStop
+		ret.OnSessionStop = func(c CoordinationSessionStopStartInfo) func(CoordinationSessionStopDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationSessionStopDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationSessionStopDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionKeepAliveTimeout
+		h2 := x.OnSessionKeepAliveTimeout
+		ret.OnSessionKeepAliveTimeout = func(c CoordinationSessionKeepAliveTimeoutInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionLost
+		h2 := x.OnSessionLost
+		ret.OnSessionLost = func(c CoordinationSessionLostInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnElectionCampaign
+		h2 := x.OnElectionCampaign
+		ret.OnElectionCampaign = func(c CoordinationElectionCampaignStartInfo) func(CoordinationElectionCampaignDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationElectionCampaignDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationElectionCampaignDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnElectionResign
+		h2 := x.OnElectionResign
+		ret.OnElectionResign = func(c CoordinationElectionResignStartInfo) func(CoordinationElectionResignDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(CoordinationElectionResignDoneInfo)
+			if h1 != nil {
+				r = h1(c)
+			}
+			if h2 != nil {
+				r1 = h2(c)
+			}
+			return func(c CoordinationElectionResignDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(c)
+				}
+				if r1 != nil {
+					r1(c)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnElectionLeadershipLost
+		h2 := x.OnElectionLeadershipLost
+		ret.OnElectionLeadershipLost = func(c CoordinationElectionLeadershipLostInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnElectionLeaderChanged
+		h2 := x.OnElectionLeaderChanged
+		ret.OnElectionLeaderChanged = func(c CoordinationElectionLeaderChangedInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
 	return &ret
 }
+func (t *Coordination) onSessionStart(c CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo) {
+	fn := t.OnSessionStart
+	if fn == nil {
+		return func(CoordinationSessionStartDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationSessionStartDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onSessionStop(c CoordinationSessionStopStartInfo) func(CoordinationSessionStopDoneInfo) {
+	fn := t.OnSessionStop
+	if fn == nil {
+		return func(CoordinationSessionStopDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationSessionStopDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onSessionKeepAliveTimeout(c CoordinationSessionKeepAliveTimeoutInfo) {
+	fn := t.OnSessionKeepAliveTimeout
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionLost(c CoordinationSessionLostInfo) {
+	fn := t.OnSessionLost
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onElectionCampaign(c CoordinationElectionCampaignStartInfo) func(CoordinationElectionCampaignDoneInfo) {
+	fn := t.OnElectionCampaign
+	if fn == nil {
+		return func(CoordinationElectionCampaignDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationElectionCampaignDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onElectionResign(c CoordinationElectionResignStartInfo) func(CoordinationElectionResignDoneInfo) {
+	fn := t.OnElectionResign
+	if fn == nil {
+		return func(CoordinationElectionResignDoneInfo) {
+			return
+		}
+	}
+	res := fn(c)
+	if res == nil {
+		return func(CoordinationElectionResignDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Coordination) onElectionLeadershipLost(c CoordinationElectionLeadershipLostInfo) {
+	fn := t.OnElectionLeadershipLost
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onElectionLeaderChanged(c CoordinationElectionLeaderChangedInfo) {
+	fn := t.OnElectionLeaderChanged
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func CoordinationOnSessionStart(t *Coordination, c *context.Context, path string, sessionID uint64) func(sessionID uint64, _ error) {
+	var p CoordinationSessionStartStartInfo
+	p.Context = c
+	p.Path = path
+	p.SessionID = sessionID
+	res := t.onSessionStart(p)
+	return func(sessionID uint64, e error) {
+		var p CoordinationSessionStartDoneInfo
+		p.SessionID = sessionID
+		p.Error = e
+		res(p)
+	}
+}
+func CoordinationOnSessionStop(t *Coordination, c *context.Context, sessionID uint64) func(error) {
+	var p CoordinationSessionStopStartInfo
+	p.Context = c
+	p.SessionID = sessionID
+	res := t.onSessionStop(p)
+	return func(e error) {
+		var p CoordinationSessionStopDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+func CoordinationOnSessionKeepAliveTimeout(t *Coordination, sessionID uint64) {
+	var p CoordinationSessionKeepAliveTimeoutInfo
+	p.SessionID = sessionID
+	t.onSessionKeepAliveTimeout(p)
+}
+func CoordinationOnSessionLost(t *Coordination, sessionID uint64, e error) {
+	var p CoordinationSessionLostInfo
+	p.SessionID = sessionID
+	p.Error = e
+	t.onSessionLost(p)
+}
+func CoordinationOnElectionCampaign(t *Coordination, c *context.Context, path string, name string) func(sessionID uint64, orderID uint64, _ error) {
+	var p CoordinationElectionCampaignStartInfo
+	p.Context = c
+	p.Path = path
+	p.Name = name
+	res := t.onElectionCampaign(p)
+	return func(sessionID uint64, orderID uint64, e error) {
+		var p CoordinationElectionCampaignDoneInfo
+		p.SessionID = sessionID
+		p.OrderID = orderID
+		p.Error = e
+		res(p)
+	}
+}
+func CoordinationOnElectionResign(t *Coordination, c *context.Context, path string, name string) func(error) {
+	var p CoordinationElectionResignStartInfo
+	p.Context = c
+	p.Path = path
+	p.Name = name
+	res := t.onElectionResign(p)
+	return func(e error) {
+		var p CoordinationElectionResignDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+func CoordinationOnElectionLeadershipLost(t *Coordination, path string, name string, sessionID uint64) {
+	var p CoordinationElectionLeadershipLostInfo
+	p.Path = path
+	p.Name = name
+	p.SessionID = sessionID
+	t.onElectionLeadershipLost(p)
+}
+func CoordinationOnElectionLeaderChanged(t *Coordination, path string, name string, sessionID uint64, orderID uint64) {
+	var p CoordinationElectionLeaderChangedInfo
+	p.Path = path
+	p.Name = name
+	p.SessionID = sessionID
+	p.OrderID = orderID
+	t.onElectionLeaderChanged(p)
+}
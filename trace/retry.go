@@ -13,6 +13,8 @@ type (
 	// gtrace:gen
 	Retry struct {
 		OnRetry func(RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo)
+
+		OnBudgetExhausted func(RetryBudgetExhaustedInfo)
 	}
 	RetryLoopStartInfo struct {
 		// Context make available context in trace callback function.
@@ -31,4 +33,14 @@ type (
 		Attempts int
 		Error    error
 	}
+	RetryBudgetExhaustedInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  *context.Context
+		ID       string
+		Attempts int   // attempts made before budget exhaustion
+		Error    error // last error of retry operation
+	}
 )
@@ -81,6 +81,25 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 			}
 		}
 	}
+	{
+		h1 := t.OnBudgetExhausted
+		h2 := x.OnBudgetExhausted
+		ret.OnBudgetExhausted = func(r RetryBudgetExhaustedInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(r)
+			}
+			if h2 != nil {
+				h2(r)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) func(RetryLoopDoneInfo) {
@@ -110,6 +129,13 @@ func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopIntermediateInfo) fu
 		return res
 	}
 }
+func (t *Retry) onBudgetExhausted(r RetryBudgetExhaustedInfo) {
+	fn := t.OnBudgetExhausted
+	if fn == nil {
+		return
+	}
+	fn(r)
+}
 func RetryOnRetry(t *Retry, c *context.Context, iD string, idempotent bool, nestedCall bool) func(error) func(attempts int, _ error) {
 	var p RetryLoopStartInfo
 	p.Context = c
@@ -129,3 +155,11 @@ func RetryOnRetry(t *Retry, c *context.Context, iD string, idempotent bool, nest
 		}
 	}
 }
+func RetryOnBudgetExhausted(t *Retry, c *context.Context, iD string, attempts int, e error) {
+	var p RetryBudgetExhaustedInfo
+	p.Context = c
+	p.ID = iD
+	p.Attempts = attempts
+	p.Error = e
+	t.onBudgetExhausted(p)
+}
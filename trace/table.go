@@ -33,6 +33,10 @@ type (
 		OnSessionQueryPrepare func(TablePrepareDataQueryStartInfo) func(TablePrepareDataQueryDoneInfo)
 		OnSessionQueryExecute func(TableExecuteDataQueryStartInfo) func(TableExecuteDataQueryDoneInfo)
 		OnSessionQueryExplain func(TableExplainQueryStartInfo) func(TableExplainQueryDoneInfo)
+		// OnSessionQueryCache is called on lookup of prepared statement in session cache
+		OnSessionQueryCache func(TableSessionQueryCacheInfo)
+		// OnBulkWriterFlush is called on BulkUpsert call with batch of rows from table.BulkWriter
+		OnBulkWriterFlush func(TableBulkWriterFlushStartInfo) func(TableBulkWriterFlushDoneInfo)
 		// Stream events
 		OnSessionQueryStreamExecute func(
 			TableSessionQueryStreamExecuteStartInfo,
@@ -123,6 +127,16 @@ type (
 		Session tableSessionInfo
 		Error   error
 	}
+	TableSessionQueryCacheInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Session tableSessionInfo
+		Query   string
+		Hit     bool
+	}
 	TableKeepAliveStartInfo struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
@@ -295,6 +309,19 @@ type (
 	TableInitDoneInfo struct {
 		Limit int
 	}
+	TableBulkWriterFlushStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Path    string
+		Rows    int
+		Bytes   int
+	}
+	TableBulkWriterFlushDoneInfo struct {
+		Error error
+	}
 	TablePoolStateChangeInfo struct {
 		Size  int
 		Event string
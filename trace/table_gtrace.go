@@ -463,6 +463,60 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 			}
 		}
 	}
+	{
+		h1 := t.OnSessionQueryCache
+		h2 := x.OnSessionQueryCache
+		ret.OnSessionQueryCache = func(t TableSessionQueryCacheInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(t)
+			}
+			if h2 != nil {
+				h2(t)
+			}
+		}
+	}
+	{
+		h1 := t.OnBulkWriterFlush
+		h2 := x.OnBulkWriterFlush
+		ret.OnBulkWriterFlush = func(t TableBulkWriterFlushStartInfo) func(TableBulkWriterFlushDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(TableBulkWriterFlushDoneInfo)
+			if h1 != nil {
+				r = h1(t)
+			}
+			if h2 != nil {
+				r1 = h2(t)
+			}
+			return func(t TableBulkWriterFlushDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(t)
+				}
+				if r1 != nil {
+					r1(t)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnSessionQueryStreamExecute
 		h2 := x.OnSessionQueryStreamExecute
@@ -1175,6 +1229,28 @@ func (t *Table) onSessionQueryExplain(t1 TableExplainQueryStartInfo) func(TableE
 	}
 	return res
 }
+func (t *Table) onSessionQueryCache(t1 TableSessionQueryCacheInfo) {
+	fn := t.OnSessionQueryCache
+	if fn == nil {
+		return
+	}
+	fn(t1)
+}
+func (t *Table) onBulkWriterFlush(t1 TableBulkWriterFlushStartInfo) func(TableBulkWriterFlushDoneInfo) {
+	fn := t.OnBulkWriterFlush
+	if fn == nil {
+		return func(TableBulkWriterFlushDoneInfo) {
+			return
+		}
+	}
+	res := fn(t1)
+	if res == nil {
+		return func(TableBulkWriterFlushDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Table) onSessionQueryStreamExecute(t1 TableSessionQueryStreamExecuteStartInfo) func(TableSessionQueryStreamExecuteIntermediateInfo) func(TableSessionQueryStreamExecuteDoneInfo) {
 	fn := t.OnSessionQueryStreamExecute
 	if fn == nil {
@@ -1550,6 +1626,27 @@ func TableOnSessionQueryExplain(t *Table, c *context.Context, session tableSessi
 		res(p)
 	}
 }
+func TableOnSessionQueryCache(t *Table, c *context.Context, session tableSessionInfo, query string, hit bool) {
+	var p TableSessionQueryCacheInfo
+	p.Context = c
+	p.Session = session
+	p.Query = query
+	p.Hit = hit
+	t.onSessionQueryCache(p)
+}
+func TableOnBulkWriterFlush(t *Table, c *context.Context, path string, rows int, bytes int) func(error) {
+	var p TableBulkWriterFlushStartInfo
+	p.Context = c
+	p.Path = path
+	p.Rows = rows
+	p.Bytes = bytes
+	res := t.onBulkWriterFlush(p)
+	return func(e error) {
+		var p TableBulkWriterFlushDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
 func TableOnSessionQueryStreamExecute(t *Table, c *context.Context, session tableSessionInfo, query tableDataQuery, parameters tableQueryParameters) func(error) func(error) {
 	var p TableSessionQueryStreamExecuteStartInfo
 	p.Context = c
@@ -65,6 +65,7 @@ type (
 		OnWriterCompressMessages       func(TopicWriterCompressMessagesStartInfo) func(TopicWriterCompressMessagesDoneInfo)
 		OnWriterSendMessages           func(TopicWriterSendMessagesStartInfo) func(TopicWriterSendMessagesDoneInfo)
 		OnWriterReadUnknownGrpcMessage func(TopicOnWriterReadUnknownGrpcMessageInfo)
+		OnWriterQueueStateChange       func(TopicWriterQueueStateChangeInfo)
 	}
 
 	// TopicReaderPartitionReadStartResponseStartInfo
@@ -470,6 +471,13 @@ type (
 		SessionID        string
 		Error            error
 	}
+
+	// TopicWriterQueueStateChangeInfo reports count of messages which are written but not acked by server yet
+	TopicWriterQueueStateChangeInfo struct {
+		WriterInstanceID string
+		Topic            string
+		Size             int
+	}
 )
 
 type TopicWriterCompressMessagesReason string
@@ -704,6 +704,25 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 			}
 		}
 	}
+	{
+		h1 := t.OnWriterQueueStateChange
+		h2 := x.OnWriterQueueStateChange
+		ret.OnWriterQueueStateChange = func(t TopicWriterQueueStateChangeInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(t)
+			}
+			if h2 != nil {
+				h2(t)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Topic) onReaderStart(info TopicReaderStartInfo) {
@@ -992,6 +1011,13 @@ func (t *Topic) onWriterReadUnknownGrpcMessage(t1 TopicOnWriterReadUnknownGrpcMe
 	}
 	fn(t1)
 }
+func (t *Topic) onWriterQueueStateChange(t1 TopicWriterQueueStateChangeInfo) {
+	fn := t.OnWriterQueueStateChange
+	if fn == nil {
+		return
+	}
+	fn(t1)
+}
 func TopicOnReaderStart(t *Topic, readerID int64, consumer string) {
 	var p TopicReaderStartInfo
 	p.ReaderID = readerID
@@ -1243,3 +1269,10 @@ func TopicOnWriterReadUnknownGrpcMessage(t *Topic, writerInstanceID string, sess
 	p.Error = e
 	t.onWriterReadUnknownGrpcMessage(p)
 }
+func TopicOnWriterQueueStateChange(t *Topic, writerInstanceID string, topic string, size int) {
+	var p TopicWriterQueueStateChangeInfo
+	p.WriterInstanceID = writerInstanceID
+	p.Topic = topic
+	p.Size = size
+	t.onWriterQueueStateChange(p)
+}